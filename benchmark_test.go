@@ -2,9 +2,12 @@ package degache
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/degache-go/degache/constants"
 	"github.com/degache-go/degache/formatters"
 	"github.com/degache-go/degache/types"
 )
@@ -193,3 +196,61 @@ func BenchmarkErrorCases(b *testing.B) {
 		}
 	})
 }
+
+// The regular expression implementations replaced by byte scanners, kept to
+// measure the gain. validators.TestScannersMatchRegexImplementation checks
+// that both accept the same inputs.
+var (
+	regexCIN           = regexp.MustCompile(`^[01]\d{7}$`)
+	regexPhone         = regexp.MustCompile(`^[` + strings.Join(constants.ValidPrefixes, "") + `]\d{7}$`)
+	regexNonDigit      = regexp.MustCompile(`\D`)
+	regexTaxID         = regexp.MustCompile(`^\d{7}[A-Z]/[A-Z]/[A-Z]/\d{3}$`)
+	regexRIB           = regexp.MustCompile(`^\d{20}$`)
+	regexPostalCode    = regexp.MustCompile(`^\d{4}$`)
+	regexSpaces        = regexp.MustCompile(`\s+`)
+	regexStandardPlate = regexp.MustCompile(`^\d{3}\s*تونس\s*\d{4}$`)
+)
+
+// Benchmark the byte scanners against the regular expression implementations
+func BenchmarkScannersVsRegex(b *testing.B) {
+	benchmarks := []struct {
+		name    string
+		input   string
+		regex   func(string) bool
+		scanner func(string) bool
+	}{
+		{"CIN", "12345678", regexCIN.MatchString, func(s string) bool { return ValidateCIN(s) }},
+		{"PhoneNumber", "+216 20 123 456", func(s string) bool {
+			s = regexNonDigit.ReplaceAllString(strings.TrimPrefix(s, constants.CountryCode), "")
+			return regexPhone.MatchString(s)
+		}, func(s string) bool { return ValidatePhoneNumber(s) }},
+		{"TaxID", "1234567A/P/M/000", regexTaxID.MatchString, func(s string) bool { return ValidateTaxID(s) }},
+		{"RIB", "01234567890123456789", func(s string) bool {
+			if !regexRIB.MatchString(s) {
+				return false
+			}
+			_, exists := constants.Banks[s[:2]]
+			return exists
+		}, func(s string) bool { return ValidateRIB(s) }},
+		{"PostalCode", "1000", regexPostalCode.MatchString, func(s string) bool { return ValidatePostalCode(s) }},
+		{"CarPlate", "123 تونس 4567", func(s string) bool {
+			return regexStandardPlate.MatchString(regexSpaces.ReplaceAllString(strings.TrimSpace(s), " "))
+		}, func(s string) bool { return ValidateCarPlate(s) }},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name+"/Regex", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.regex(bm.input)
+			}
+		})
+
+		b.Run(bm.name+"/Scanner", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.scanner(bm.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestCABI builds the shared library and a C program calling every exported function through it
+func TestCABI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the shared library")
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("the test links a .so")
+	}
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler")
+	}
+
+	dir := t.TempDir()
+	build := exec.Command("go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "libdegache.so"), ".")
+	build.Env = append(os.Environ(), "CGO_ENABLED=1")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("go build -buildmode=c-shared: %v\n%s", err, out)
+	}
+
+	program := filepath.Join(dir, "abi_test")
+	compile := exec.Command(cc, "-Wall", "-Werror", "-o", program, filepath.Join("testdata", "abi_test.c"),
+		"-I", dir, "-L", dir, "-ldegache", "-Wl,-rpath,"+dir)
+	if out, err := compile.CombinedOutput(); err != nil {
+		t.Fatalf("cc: %v\n%s", err, out)
+	}
+
+	out, err := exec.Command(program).CombinedOutput()
+	if err != nil {
+		t.Fatalf("abi_test: %v\n%s", err, out)
+	}
+
+	want := []string{
+		"abi 1 1",
+		"cin 0",
+		"cin 2 CIN must be exactly 8 digits",
+		"phone_strict 8 Phone number format is invalid in strict mode",
+		"tax_id 0",
+		"rib 6 Bank code not recognized",
+		"rib_checksum 7",
+		"postal 4 Postal code must contain only digits",
+		"plate 0",
+		"null -1",
+		"format_phone 0 20 123 456",
+		"format_phone 5 invalid phone number: 10123456: Phone number must start with 2-9 and contain only digits",
+		"format_date 0 15/01/2024",
+		"format_style -2",
+		"format_currency 0 1.234,500 TND",
+		"parse_currency 0 1234.500",
+		"bank 0 Banque Centrale de Tunisie",
+		"bank 10",
+		"carrier 0 Tunisie Telecom",
+	}
+	got := strings.Split(strings.TrimSpace(string(out)), "\n")
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Errorf("line %d:\n got: %q\nwant: %q\nfull output:\n%s", i+1, got[min(i, len(got)-1)], want[i], out)
+			return
+		}
+	}
+}
@@ -0,0 +1,299 @@
+// Command capi builds degache as a C shared library with a stable C ABI, for
+// programs written in C, C++, PHP (FFI) and other languages that can load one:
+//
+//	go build -buildmode=c-shared -o libdegache.so ./capi
+//
+// The build also writes libdegache.h, which declares the functions below and
+// the DEGACHE_* constants.
+//
+// Conventions:
+//   - Strings are NUL-terminated UTF-8. Input strings (degache_cstring) are
+//     borrowed and never freed.
+//   - Validation and formatting functions return DEGACHE_OK (0) on success, a
+//     positive DEGACHE_ERR_* code when the value is invalid and a negative code
+//     on API misuse (NULL argument, unknown style).
+//   - Functions with a char** out parameter store a newly allocated string in
+//     it, if it is not NULL: the result on success, the error message on
+//     failure. The caller frees it with degache_free.
+//   - DEGACHE_ABI_VERSION changes only when an existing signature or code changes.
+//
+// Example:
+//
+//	char *message = NULL;
+//	if (degache_validate_rib("01234567890123456789", 0, &message) != DEGACHE_OK) {
+//	    fprintf(stderr, "invalid RIB: %s\n", message);
+//	}
+//	degache_free(message);
+package main
+
+/*
+#include <stdlib.h>
+
+#define DEGACHE_ABI_VERSION 1
+
+// Input strings, borrowed for the duration of the call
+typedef const char *degache_cstring;
+
+// Return codes
+#define DEGACHE_OK                     0
+#define DEGACHE_ERR_EMPTY              1
+#define DEGACHE_ERR_TOO_SHORT          2
+#define DEGACHE_ERR_TOO_LONG           3
+#define DEGACHE_ERR_INVALID_CHARACTER  4
+#define DEGACHE_ERR_BAD_PREFIX         5
+#define DEGACHE_ERR_UNKNOWN_BANK       6
+#define DEGACHE_ERR_CHECKSUM           7
+#define DEGACHE_ERR_BAD_FORMAT         8
+#define DEGACHE_ERR_UNSUPPORTED_TYPE   9
+#define DEGACHE_ERR_NOT_FOUND          10
+#define DEGACHE_ERR_INVALID            11
+#define DEGACHE_ERR_NULL_ARGUMENT      (-1)
+#define DEGACHE_ERR_UNKNOWN_STYLE      (-2)
+
+// Validation flags
+#define DEGACHE_STRICT         1
+#define DEGACHE_SPECIAL_PLATE  2
+
+// Currency formatting flags
+#define DEGACHE_CURRENCY_SYMBOL  1
+#define DEGACHE_CURRENCY_CODE    2
+*/
+import "C"
+
+import (
+	"errors"
+	"strings"
+	"unsafe"
+
+	"github.com/degache-go/degache"
+	"github.com/degache-go/degache/formatters"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// main is required by -buildmode=c-shared and never runs
+func main() {}
+
+// errorCodes maps validation error codes to DEGACHE_ERR_* codes
+var errorCodes = map[types.ErrorCode]C.int{
+	types.ErrEmpty:            C.DEGACHE_ERR_EMPTY,
+	types.ErrTooShort:         C.DEGACHE_ERR_TOO_SHORT,
+	types.ErrTooLong:          C.DEGACHE_ERR_TOO_LONG,
+	types.ErrInvalidCharacter: C.DEGACHE_ERR_INVALID_CHARACTER,
+	types.ErrBadPrefix:        C.DEGACHE_ERR_BAD_PREFIX,
+	types.ErrUnknownBank:      C.DEGACHE_ERR_UNKNOWN_BANK,
+	types.ErrChecksum:         C.DEGACHE_ERR_CHECKSUM,
+	types.ErrBadFormat:        C.DEGACHE_ERR_BAD_FORMAT,
+	types.ErrUnsupportedType:  C.DEGACHE_ERR_UNSUPPORTED_TYPE,
+	types.ErrInvalid:          C.DEGACHE_ERR_INVALID,
+}
+
+// setString stores a copy of s in *out, if out is not NULL
+func setString(out **C.char, s string) {
+	if out != nil {
+		*out = C.CString(s)
+	}
+}
+
+// respond stores value or the error message in *out and returns the matching code
+func respond(value string, err error, out **C.char) C.int {
+	if err == nil {
+		setString(out, value)
+		return C.DEGACHE_OK
+	}
+
+	setString(out, err.Error())
+	if errors.Is(err, formatters.ErrUnknownStyle) {
+		return C.DEGACHE_ERR_UNKNOWN_STYLE
+	}
+	if verr := validators.AsValidationError(err); verr != nil {
+		if code, ok := errorCodes[verr.Code]; ok {
+			return code
+		}
+	}
+	return C.DEGACHE_ERR_INVALID
+}
+
+// check validates a C string with fn, passing the DEGACHE_STRICT flag
+func check(value C.degache_cstring, flags C.int, message **C.char, fn func(string, bool) error) C.int {
+	if value == nil {
+		setString(message, "value is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+	return respond("", fn(C.GoString(value), flags&C.DEGACHE_STRICT != 0), message)
+}
+
+//export degache_abi_version
+func degache_abi_version() C.int {
+	return C.DEGACHE_ABI_VERSION
+}
+
+// degache_version returns the library version; free it with degache_free
+//
+//export degache_version
+func degache_version() *C.char {
+	return C.CString(degache.Version)
+}
+
+// degache_free frees a string returned by the library; NULL is ignored
+//
+//export degache_free
+func degache_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+//export degache_validate_cin
+func degache_validate_cin(cin C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(cin, flags, message, func(s string, strict bool) error {
+		return validators.CheckCIN(s, types.ValidationOptions{Strict: strict})
+	})
+}
+
+//export degache_validate_phone
+func degache_validate_phone(phone C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(phone, flags, message, func(s string, strict bool) error {
+		return validators.CheckPhoneNumber(s, types.PhoneNumberValidationOptions{Strict: strict})
+	})
+}
+
+//export degache_validate_tax_id
+func degache_validate_tax_id(taxID C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(taxID, flags, message, func(s string, strict bool) error {
+		return validators.CheckTaxID(s, types.ValidationOptions{Strict: strict})
+	})
+}
+
+//export degache_validate_rib
+func degache_validate_rib(rib C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(rib, flags, message, func(s string, strict bool) error {
+		return validators.CheckRIB(s, types.ValidationOptions{Strict: strict})
+	})
+}
+
+//export degache_validate_rib_checksum
+func degache_validate_rib_checksum(rib C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(rib, flags, message, func(s string, strict bool) error {
+		return validators.CheckRIBChecksum(s, types.ValidationOptions{Strict: strict})
+	})
+}
+
+//export degache_validate_postal_code
+func degache_validate_postal_code(postalCode C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(postalCode, flags, message, func(s string, strict bool) error {
+		return validators.CheckPostalCode(s, types.ValidationOptions{Strict: strict})
+	})
+}
+
+// degache_validate_car_plate validates a standard plate, or a special one with DEGACHE_SPECIAL_PLATE
+//
+//export degache_validate_car_plate
+func degache_validate_car_plate(carPlate C.degache_cstring, flags C.int, message **C.char) C.int {
+	return check(carPlate, flags, message, func(s string, strict bool) error {
+		opts := types.CarPlateValidationOptions{Strict: strict}
+		if flags&C.DEGACHE_SPECIAL_PLATE != 0 {
+			opts.Type = "special"
+		}
+		return validators.CheckCarPlate(s, opts)
+	})
+}
+
+// degache_format formats value by kind ("phone", "currency", "date") and style
+// (see formatters.Styles; NULL or "" selects the default style)
+//
+//export degache_format
+func degache_format(kind, style, value C.degache_cstring, result **C.char) C.int {
+	if kind == nil {
+		setString(result, "kind is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+	return format(strings.ToLower(C.GoString(kind)), style, value, result)
+}
+
+// degache_format_phone formats a phone number: style is "international"
+// (default), "national", "compact" or "normalized"
+//
+//export degache_format_phone
+func degache_format_phone(phone, style C.degache_cstring, result **C.char) C.int {
+	return format("phone", style, phone, result)
+}
+
+// format formats a C string with formatters.Format
+func format(kind string, style, value C.degache_cstring, result **C.char) C.int {
+	if value == nil {
+		setString(result, "value is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+
+	goStyle := ""
+	if style != nil {
+		goStyle = C.GoString(style)
+	}
+	formatted, err := formatters.Format(kind, goStyle, C.GoString(value))
+	return respond(formatted, err, result)
+}
+
+// degache_format_currency formats an amount in dinars; flags combine
+// DEGACHE_CURRENCY_SYMBOL and DEGACHE_CURRENCY_CODE
+//
+//export degache_format_currency
+func degache_format_currency(amount C.double, flags C.int, result **C.char) C.int {
+	opts := types.CurrencyFormatOptions{
+		Symbol: flags&C.DEGACHE_CURRENCY_SYMBOL != 0,
+		Code:   flags&C.DEGACHE_CURRENCY_CODE != 0,
+	}
+	return respond(formatters.FormatCurrency(float64(amount), opts), nil, result)
+}
+
+// degache_parse_currency parses an amount in Tunisian format ("1.234,500 TND")
+//
+//export degache_parse_currency
+func degache_parse_currency(s C.degache_cstring, amount *C.double, message **C.char) C.int {
+	if s == nil || amount == nil {
+		setString(message, "argument is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+
+	parsed, err := formatters.ParseCurrency(C.GoString(s))
+	if err == nil {
+		*amount = C.double(parsed)
+	}
+	return respond("", err, message)
+}
+
+// degache_bank_name stores the name of the bank of a RIB or 2-digit bank code in *result
+//
+//export degache_bank_name
+func degache_bank_name(ribOrCode C.degache_cstring, result **C.char) C.int {
+	if ribOrCode == nil {
+		setString(result, "value is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+
+	value := C.GoString(ribOrCode)
+	if code := validators.Normalize(value); len(code) == 2 {
+		if bank, ok := degache.Banks[code]; ok {
+			return respond(bank.Name, nil, result)
+		}
+	} else if info := validators.GetBankFromRIB(value); info != nil {
+		return respond(info.Bank.Name, nil, result)
+	}
+	setString(result, "no bank found for "+value)
+	return C.DEGACHE_ERR_NOT_FOUND
+}
+
+// degache_carrier_name stores the name of the mobile carrier of a phone number in *result
+//
+//export degache_carrier_name
+func degache_carrier_name(phone C.degache_cstring, result **C.char) C.int {
+	if phone == nil {
+		setString(result, "value is NULL")
+		return C.DEGACHE_ERR_NULL_ARGUMENT
+	}
+
+	info := validators.GetCarrierInfo(C.GoString(phone))
+	if info == nil {
+		setString(result, "no carrier found for "+C.GoString(phone))
+		return C.DEGACHE_ERR_NOT_FOUND
+	}
+	return respond(info.Carrier.Name, nil, result)
+}
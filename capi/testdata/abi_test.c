@@ -0,0 +1,61 @@
+// abi_test.c calls every function of libdegache and prints the results, one per line.
+// It is compiled and run by TestCABI.
+#include <stdio.h>
+#include "libdegache.h"
+
+static void print_result(const char *name, int code, char *text) {
+	printf("%s %d %s\n", name, code, text ? text : "(null)");
+	degache_free(text);
+}
+
+int main(void) {
+	char *text = NULL;
+	double amount = 0;
+	int code;
+
+	char *version = degache_version();
+	printf("abi %d %d\n", degache_abi_version(), DEGACHE_ABI_VERSION);
+	degache_free(version);
+
+	code = degache_validate_cin("12345678", 0, NULL);
+	printf("cin %d\n", code);
+	code = degache_validate_cin("2234567", 0, &text);
+	print_result("cin", code, text);
+	code = degache_validate_phone("20 123 456", DEGACHE_STRICT, &text);
+	print_result("phone_strict", code, text);
+	code = degache_validate_tax_id("1234567A/P/M/000", 0, NULL);
+	printf("tax_id %d\n", code);
+	code = degache_validate_rib("99234567890123456789", 0, &text);
+	print_result("rib", code, text);
+	code = degache_validate_rib_checksum("01234567890123456789", 0, NULL);
+	printf("rib_checksum %d\n", code);
+	code = degache_validate_postal_code("10a0", 0, &text);
+	print_result("postal", code, text);
+	code = degache_validate_car_plate("RS 123 \xd8\xaa\xd9\x88\xd9\x86\xd8\xb3", DEGACHE_SPECIAL_PLATE, NULL);
+	printf("plate %d\n", code);
+	code = degache_validate_cin(NULL, 0, NULL);
+	printf("null %d\n", code);
+
+	code = degache_format_phone("20123456", "national", &text);
+	print_result("format_phone", code, text);
+	code = degache_format_phone("10123456", NULL, &text);
+	print_result("format_phone", code, text);
+	code = degache_format("date", "numeric", "2024-01-15", &text);
+	print_result("format_date", code, text);
+	code = degache_format("phone", "fancy", "20123456", &text);
+	printf("format_style %d\n", code);
+	degache_free(text);
+	code = degache_format_currency(1234.5, DEGACHE_CURRENCY_CODE, &text);
+	print_result("format_currency", code, text);
+	code = degache_parse_currency("1.234,500 TND", &amount, NULL);
+	printf("parse_currency %d %.3f\n", code, amount);
+
+	code = degache_bank_name("01234567890123456789", &text);
+	print_result("bank", code, text);
+	code = degache_bank_name("99", &text);
+	printf("bank %d\n", code);
+	degache_free(text);
+	code = degache_carrier_name("98123456", &text);
+	print_result("carrier", code, text);
+	return 0;
+}
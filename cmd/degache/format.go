@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/degache-go/degache/formatters"
+)
+
+// formatResult is the JSON output of "format" for one value
+type formatResult struct {
+	Value     string `json:"value"`
+	Formatted string `json:"formatted,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// runFormat implements "degache format"
+func runFormat(e *env, args []string) int {
+	fs := newFlagSet(e, "format", formatUsage)
+	asJSON := fs.Bool("json", false, "write one JSON object per value")
+	style := fs.String("style", "", "phone: international, national, compact, normalized; "+
+		"currency: plain, symbol, code, compact; date: long, short, numeric, datetime")
+
+	kind, values, code, ok := parseCommand(fs, args)
+	if !ok {
+		return code
+	}
+
+	if _, err := formatters.Format(kind, *style, ""); errors.Is(err, formatters.ErrUnknownStyle) {
+		fmt.Fprintln(e.stderr, "degache:", err)
+		fs.Usage()
+		return exitUsage
+	}
+
+	out := newOutput(e, *asJSON)
+	invalid := false
+	err := e.eachInput(values, func(value string) {
+		formatted, err := formatters.Format(kind, *style, value)
+		if err != nil {
+			invalid = true
+			out.fail(formatResult{Value: value, Error: err.Error()}, err)
+			return
+		}
+		out.write(formatResult{Value: value, Formatted: formatted}, formatted)
+	})
+
+	return exitCode(e, invalid, err)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/degache-go/degache/identify"
+)
+
+// identifyResult is the JSON output of "degache identify --json" for one value
+type identifyResult struct {
+	Value      string               `json:"value"`
+	Candidates []identify.Candidate `json:"candidates"`
+}
+
+// runIdentify implements "degache identify"
+func runIdentify(e *env, args []string) int {
+	fs := newFlagSet(e, "identify", identifyUsage)
+	asJSON := fs.Bool("json", false, "write one JSON object per line")
+	column := fs.Bool("column", false, "infer the kind of the values taken together, as a column")
+
+	values, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	out := newOutput(e, *asJSON)
+	if *column {
+		var sample []string
+		if err := e.eachInput(values, func(value string) { sample = append(sample, value) }); err != nil {
+			return exitCode(e, false, err)
+		}
+
+		profile := identify.ProfileColumn(sample)
+		if *asJSON {
+			out.write(profile)
+		} else {
+			for _, score := range profile.Kinds {
+				out.write(nil, score.Kind, fmt.Sprint(score.Confidence), fmt.Sprintf("%d/%d", score.Matches, profile.Values))
+			}
+		}
+		return exitCode(e, profile.Kind == "", nil)
+	}
+
+	unknown := false
+	err = e.eachInput(values, func(value string) {
+		candidates := identify.Identify(value)
+		unknown = unknown || len(candidates) == 0
+
+		text := []string{value}
+		for _, c := range candidates {
+			text = append(text, fmt.Sprintf("%s %v", c.Kind, c.Confidence))
+		}
+		if len(candidates) == 0 {
+			text = append(text, "unknown")
+			candidates = []identify.Candidate{}
+		}
+		out.write(identifyResult{Value: value, Candidates: candidates}, text...)
+	})
+	return exitCode(e, unknown, err)
+}
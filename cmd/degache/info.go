@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/validators"
+)
+
+// bankInfo is the output of "info bank"
+type bankInfo struct {
+	Input string `json:"input,omitempty"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+}
+
+// carrierInfo is the output of "info carrier"
+type carrierInfo struct {
+	Input    string   `json:"input,omitempty"`
+	Name     string   `json:"name"`
+	Prefix   string   `json:"prefix,omitempty"`
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// governorateInfo is the output of "info governorate"
+type governorateInfo struct {
+	Input      string `json:"input,omitempty"`
+	Name       string `json:"name"`
+	PostalCode string `json:"postalCode"`
+	Region     string `json:"region"`
+}
+
+// infoError is the JSON output of "info" for a value without a match
+type infoError struct {
+	Input string `json:"input"`
+	Error string `json:"error"`
+}
+
+// runInfo implements "degache info"
+func runInfo(e *env, args []string) int {
+	fs := newFlagSet(e, "info", infoUsage)
+	asJSON := fs.Bool("json", false, "write one JSON object per value")
+	all := fs.Bool("all", false, "list every known entry")
+
+	kind, values, code, ok := parseCommand(fs, args)
+	if !ok {
+		return code
+	}
+
+	out := newOutput(e, *asJSON)
+	var lookup func(value string) (any, []string, bool)
+	var list func()
+
+	switch kind {
+	case "bank":
+		lookup = lookupBank
+		list = func() {
+			for _, code := range sortedKeys(constants.Banks) {
+				bank := constants.Banks[code]
+				out.write(bankInfo{Code: bank.Code, Name: bank.Name}, bank.Code, bank.Name)
+			}
+		}
+	case "carrier":
+		lookup = lookupCarrier
+		list = func() {
+			for _, key := range sortedKeys(constants.Carriers) {
+				carrier := constants.Carriers[key]
+				prefixes := strings.Join(carrier.Prefixes, ",")
+				out.write(carrierInfo{Name: carrier.Name, Prefixes: carrier.Prefixes}, prefixes, carrier.Name)
+			}
+		}
+	case "governorate":
+		lookup = lookupGovernorate
+		list = func() {
+			for _, key := range sortedKeys(constants.Governorates) {
+				g := constants.Governorates[key]
+				out.write(governorateInfo{Name: g.Name, PostalCode: g.PostalCode, Region: g.Region}, g.PostalCode, g.Name, g.Region)
+			}
+		}
+	default:
+		fmt.Fprintf(e.stderr, "degache: unknown kind %q\n", kind)
+		fs.Usage()
+		return exitUsage
+	}
+
+	if *all {
+		list()
+		return exitOK
+	}
+
+	invalid := false
+	err := e.eachInput(values, func(value string) {
+		info, text, found := lookup(value)
+		if !found {
+			invalid = true
+			out.fail(infoError{Input: value, Error: errNotFound.Error()}, fmt.Errorf("%s %w: %s", kind, errNotFound, value))
+			return
+		}
+		out.write(info, append([]string{value}, text...)...)
+	})
+
+	return exitCode(e, invalid, err)
+}
+
+// lookupBank finds a bank by its 2-digit code or from a RIB
+func lookupBank(value string) (any, []string, bool) {
+	var bank constants.Bank
+	if code := validators.Normalize(value); len(code) == 2 {
+		found, ok := constants.Banks[code]
+		if !ok {
+			return nil, nil, false
+		}
+		bank = found
+	} else {
+		info := validators.GetBankFromRIB(value)
+		if info == nil {
+			return nil, nil, false
+		}
+		bank = info.Bank
+	}
+	return bankInfo{Input: value, Code: bank.Code, Name: bank.Name}, []string{bank.Code, bank.Name}, true
+}
+
+// lookupCarrier finds the carrier of a phone number
+func lookupCarrier(value string) (any, []string, bool) {
+	info := validators.GetCarrierInfo(value)
+	if info == nil {
+		return nil, nil, false
+	}
+	return carrierInfo{Input: value, Name: info.Carrier.Name, Prefix: info.Prefix}, []string{info.Carrier.Name}, true
+}
+
+// lookupGovernorate finds a governorate by postal code or by name
+func lookupGovernorate(value string) (any, []string, bool) {
+	g := validators.GetGovernorateFromPostalCode(value)
+	if g == nil {
+		g = validators.GetGovernorateByName(value)
+	}
+	if g == nil {
+		return nil, nil, false
+	}
+	return governorateInfo{Input: value, Name: g.Name, PostalCode: g.PostalCode, Region: g.Region},
+		[]string{g.Name, g.PostalCode, g.Region}, true
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,305 @@
+// Command degache validates, formats and describes Tunisian data from the command line.
+//
+// Usage:
+//
+//	degache validate cin|phone|rib|taxid|plate|postal [flags] [values...]
+//	degache format phone|currency|date [flags] [values...]
+//	degache info bank|carrier|governorate [flags] [values...]
+//	degache identify [flags] [values...]
+//	degache schema [flags] [names...]
+//	degache patterns [flags] [names...]
+//	degache redact [flags] [texts...]
+//	degache serve [flags]
+//	degache rpc
+//	degache version
+//
+// Values are read from the arguments or, when there are none, from standard
+// input, one per line. With --json, every result is written as one JSON object
+// per line. The exit code is 0 when every value is valid, 1 when at least one
+// is invalid and 2 on usage errors, so the tool can be used in shell pipelines:
+//
+//	cut -d, -f3 customers.csv | degache validate phone --json
+//
+// "degache serve" exposes the same operations as a JSON HTTP API (see package
+// server) and "degache rpc" as JSON-RPC 2.0 over standard input and output
+// (see package jsonrpc).
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/degache-go/degache"
+)
+
+// Exit codes
+const (
+	exitOK      = 0 // every value is valid
+	exitInvalid = 1 // at least one value is invalid
+	exitUsage   = 2 // the command line is invalid
+)
+
+// env holds the standard streams used by a command
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// Usage lines of the subcommands
+const (
+	validateUsage = "validate cin|phone|rib|taxid|plate|postal [--json] [--strict] [--type standard|special] [--lang en|fr|ar] [values...]"
+	formatUsage   = "format phone|currency|date [--json] [--style style] [values...]"
+	identifyUsage = "identify [--json] [--column] [values...]"
+	infoUsage     = "info bank|carrier|governorate [--json] [--all] [values...]"
+	serveUsage    = "serve [--addr :8080] [--max-body bytes] [--max-batch n]"
+	rpcUsage      = "rpc [--methods]"
+	patternsUsage = "patterns [--json] [--js] [cin|phone|phoneE164|taxID|rib|iban|postal|carPlate...]"
+	redactUsage   = "redact [--json] [--mask] [--invalid] [--min-confidence n] [--kinds cin,phone,rib,taxID] [texts...]"
+	schemaUsage   = "schema [--lang en|fr|ar] [--openapi] [cin|phone|phoneE164|taxID|rib|iban|postal|carPlate...]"
+)
+
+// command is a degache subcommand
+type command struct {
+	usage   string
+	summary string
+	run     func(e *env, args []string) int
+}
+
+// commands lists the subcommands by name
+var commands = map[string]command{
+	"validate": {
+		usage:   validateUsage,
+		summary: "Validate values, exit 1 if any is invalid",
+		run:     runValidate,
+	},
+	"format": {
+		usage:   formatUsage,
+		summary: "Format phone numbers, amounts in dinars and dates",
+		run:     runFormat,
+	},
+	"identify": {
+		usage:   identifyUsage,
+		summary: "Guess which identifier each value is, or the kind of a column with --column",
+		run:     runIdentify,
+	},
+	"info": {
+		usage:   infoUsage,
+		summary: "Describe banks, mobile carriers and governorates",
+		run:     runInfo,
+	},
+	"patterns": {
+		usage:   patternsUsage,
+		summary: "Print JavaScript regular expressions and input masks of the identifiers",
+		run:     runPatterns,
+	},
+	"redact": {
+		usage:   redactUsage,
+		summary: "Replace CINs, phone numbers, RIBs and Tax IDs in text",
+		run:     runRedact,
+	},
+	"rpc": {
+		usage:   rpcUsage,
+		summary: "Answer JSON-RPC 2.0 requests on standard input, one per line",
+		run:     runRPC,
+	},
+	"schema": {
+		usage:   schemaUsage,
+		summary: "Print JSON Schema definitions of the identifiers",
+		run:     runSchema,
+	},
+	"serve": {
+		usage:   serveUsage,
+		summary: "Serve the validators, formatters and lookups as a JSON HTTP API",
+		run:     runServe,
+	},
+	"version": {
+		usage:   "version",
+		summary: "Print the version",
+		run: func(e *env, _ []string) int {
+			fmt.Fprintln(e.stdout, "degache", degache.Version)
+			return exitOK
+		},
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], &env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}))
+}
+
+// run executes the command line and returns the exit code
+func run(args []string, e *env) int {
+	if len(args) == 0 {
+		usage(e.stderr)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 1 {
+			if cmd, ok := commands[args[1]]; ok {
+				fmt.Fprintln(e.stdout, "Usage: degache", cmd.usage)
+				return exitOK
+			}
+		}
+		usage(e.stdout)
+		return exitOK
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "degache: unknown command %q\n\n", args[0])
+		usage(e.stderr)
+		return exitUsage
+	}
+	return cmd.run(e, args[1:])
+}
+
+// usage prints the list of commands
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: degache <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Values are read from the arguments, or from standard input one per line.")
+	fmt.Fprintln(w, "Exit codes: 0 all valid, 1 invalid input, 2 usage error.")
+}
+
+// newFlagSet creates the flag set of a subcommand
+func newFlagSet(e *env, name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(e.stderr, "Usage: degache", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs parses flags placed anywhere on the command line and returns the positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := fs.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// eachInput calls fn for every value given as argument or,
+// when there is none, for every non-blank line of standard input. Lines are
+// passed as written, without their terminator, so that --strict applies to
+// them as it does to arguments.
+func (e *env) eachInput(values []string, fn func(value string)) error {
+	if len(values) > 0 {
+		for _, value := range values {
+			fn(value)
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(e.stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.TrimSpace(line) != "" {
+			fn(line)
+		}
+	}
+	return scanner.Err()
+}
+
+// output writes results as text lines or JSON lines
+type output struct {
+	e    *env
+	json *json.Encoder
+}
+
+// newOutput creates an output writing JSON lines if asJSON is set
+func newOutput(e *env, asJSON bool) *output {
+	o := &output{e: e}
+	if asJSON {
+		o.json = json.NewEncoder(e.stdout)
+		o.json.SetEscapeHTML(false)
+	}
+	return o
+}
+
+// write writes v in JSON mode, or the tab-separated text fields otherwise
+func (o *output) write(v any, text ...string) {
+	if o.json != nil {
+		if err := o.json.Encode(v); err != nil {
+			fmt.Fprintln(o.e.stderr, "degache:", err)
+		}
+		return
+	}
+	fmt.Fprintln(o.e.stdout, strings.Join(text, "\t"))
+}
+
+// fail reports an error for one value: in the JSON output in JSON mode, on standard error otherwise
+func (o *output) fail(v any, err error) {
+	if o.json != nil {
+		o.write(v)
+		return
+	}
+	fmt.Fprintln(o.e.stderr, "degache:", err)
+}
+
+// exitCode converts the outcome of a command to an exit code
+func exitCode(e *env, invalid bool, err error) int {
+	if err != nil {
+		fmt.Fprintln(e.stderr, "degache:", err)
+		return exitUsage
+	}
+	if invalid {
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// parseCommand parses the flags of a command and its subcommand (e.g. "cin" in "validate cin").
+// If ok is false, the command must exit with code.
+func parseCommand(fs *flag.FlagSet, args []string) (name string, values []string, code int, ok bool) {
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return "", nil, exitOK, false
+	}
+	if err != nil {
+		return "", nil, exitUsage, false
+	}
+	if len(positional) == 0 {
+		fs.Usage()
+		return "", nil, exitUsage, false
+	}
+	return strings.ToLower(positional[0]), positional[1:], exitOK, true
+}
+
+// errNotFound is reported when info finds no match
+var errNotFound = errors.New("not found")
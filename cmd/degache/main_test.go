@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/server"
+)
+
+// runCommand runs the command line with stdin and returns the exit code and outputs
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &env{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr})
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		stdin  string
+		args   []string
+		code   int
+		stdout string
+	}{
+		{"validate valid CIN", "", []string{"validate", "cin", "12345678"}, exitOK, "12345678\tvalid\n"},
+		{"validate invalid CIN", "", []string{"validate", "cin", "12345678", "22345678"}, exitInvalid,
+			"12345678\tvalid\n22345678\tinvalid\tCIN must start with 0 or 1 and contain only digits\n"},
+		{"validate from stdin", "20123456\n\n+216 98 123 456\n", []string{"validate", "phone"}, exitOK,
+			"20123456\tvalid\n+216 98 123 456\tvalid\n"},
+		{"validate strict", "", []string{"validate", "phone", "--strict", "20 123 456"}, exitInvalid,
+			"20 123 456\tinvalid\tPhone number format is invalid in strict mode\n"},
+		{"validate strict from stdin", " 12345678\r\n12345678\r\n", []string{"validate", "cin", "--strict"}, exitInvalid,
+			" 12345678\tinvalid\tCIN must be exactly 8 digits\n12345678\tvalid\n"},
+		{"validate tax ID alias", "", []string{"validate", "TAXID", "1234567A/P/M/000"}, exitOK, "1234567A/P/M/000\tvalid\n"},
+		{"validate special plate", "", []string{"validate", "plate", "--type", "special", "RS 123 تونس"}, exitOK,
+			"RS 123 تونس\tvalid\n"},
+		{"validate localized", "", []string{"validate", "postal", "--lang", "fr", "10a0"}, exitInvalid,
+			"10a0\tinvalid\tLe code postal ne doit contenir que des chiffres\n"},
+		{"validate after --", "", []string{"validate", "cin", "--", "--json"}, exitInvalid,
+			"--json\tinvalid\tCIN must be exactly 8 digits\n"},
+		{"format phone", "", []string{"format", "phone", "20123456"}, exitOK, "+216 20 123 456\n"},
+		{"format phone national", "", []string{"format", "phone", "--style", "national", "+21620123456"}, exitOK, "20 123 456\n"},
+		{"format invalid phone", "", []string{"format", "phone", "10123456"}, exitInvalid, ""},
+		{"format currency", "", []string{"format", "currency", "--style", "code", "1234.5"}, exitOK, "1.234,500 TND\n"},
+		{"format date", "", []string{"format", "date", "--style", "numeric", "2024-01-15"}, exitOK, "15/01/2024\n"},
+		{"info bank code", "", []string{"info", "bank", "20"}, exitOK, "20\t20\tAmen Bank\n"},
+		{"info bank RIB", "", []string{"info", "bank", "01234567890123456789"}, exitOK,
+			"01234567890123456789\t01\tBanque Centrale de Tunisie\n"},
+		{"info carrier", "", []string{"info", "carrier", "98123456"}, exitOK, "98123456\tTunisie Telecom\n"},
+		{"info governorate by name", "", []string{"info", "governorate", "TUNIS"}, exitOK, "TUNIS\tTunis\t1000\tNorth\n"},
+		{"info not found", "", []string{"info", "bank", "99"}, exitInvalid, ""},
+		{"version", "", []string{"version"}, exitOK, "degache 1.0.0\n"},
+		{"no command", "", nil, exitUsage, ""},
+		{"unknown command", "", []string{"check"}, exitUsage, ""},
+		{"unknown kind", "", []string{"validate", "iban", "x"}, exitUsage, ""},
+		{"missing kind", "", []string{"format"}, exitUsage, ""},
+		{"unknown style", "", []string{"format", "phone", "--style", "fancy", "20123456"}, exitUsage, ""},
+		{"unknown flag", "", []string{"validate", "cin", "--fast"}, exitUsage, ""},
+		{"patterns", "", []string{"patterns", "postal", "taxID"}, exitOK,
+			"postal\t/^[0-9]{4}$/\t9999\ntaxID\t/^[0-9]{7}[A-Z]\\/[A-Z]\\/[A-Z]\\/[0-9]{3}$/\t9999999A/A/A/999\n"},
+		{"patterns module", "", []string{"patterns", "--js", "cin"}, exitOK,
+			"// Code generated by degache patterns --js; DO NOT EDIT.\n\nexport const cin = {\n  pattern: /^[01][0-9]{7}$/,\n" +
+				"  masks: [\"99999999\"],\n  minLength: 8,\n  maxLength: 8,\n};\n"},
+		{"identify", "", []string{"identify", "+216 98 123 456", "1000", "hello"}, exitInvalid,
+			"+216 98 123 456\tmobile 0.95\n1000\tpostal 0.6\nhello\tunknown\n"},
+		{"identify column", "20123456\n98123456\n71123456\n", []string{"identify", "--column"}, exitOK,
+			"mobile\t0.47\t2/3\nlandline\t0.22\t1/3\n"},
+		{"identify JSON", "", []string{"identify", "--json", "12345678"}, exitOK,
+			`{"value":"12345678","candidates":[{"kind":"cin","confidence":0.6,"normalized":"12345678"}]}` + "\n"},
+		{"redact", "CIN: 12345678\n\ntél ٢٠ ١٢٣ ٤٥٦\n", []string{"redact"}, exitOK, "CIN: [CIN]\n\ntél [PHONE]\n"},
+		{"redact mask", "", []string{"redact", "--mask", "--kinds", "rib", "RIB 01234567890123456789, CIN 12345678"}, exitOK,
+			"RIB 01****************89, CIN 12345678\n"},
+		{"redact JSON", "", []string{"redact", "--json", "a", "MF 1234567A/P/M/000"}, exitOK,
+			`{"line":2,"kind":"taxID","start":3,"end":19,"text":"1234567A/P/M/000","value":"1234567A/P/M/000","valid":true,"confidence":1}` + "\n"},
+		{"redact unknown kind", "", []string{"redact", "--kinds", "iban", "x"}, exitUsage, ""},
+		{"schema unknown name", "", []string{"schema", "ssn"}, exitUsage, ""},
+		{"serve extra argument", "", []string{"serve", "8080"}, exitUsage, ""},
+		{"rpc", `{"jsonrpc":"2.0","id":1,"method":"validators.ValidateCIN","params":["12345678"]}` + "\n" +
+			`{"jsonrpc":"2.0","method":"rpc.shutdown"}` + "\n", []string{"rpc"}, exitOK,
+			`{"jsonrpc":"2.0","id":1,"result":true}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCommand(tt.stdin, tt.args...)
+			if code != tt.code {
+				t.Errorf("run(%q) exit code = %d, want %d (stderr: %s)", tt.args, code, tt.code, stderr)
+			}
+			if stdout != tt.stdout {
+				t.Errorf("run(%q) stdout = %q, want %q", tt.args, stdout, tt.stdout)
+			}
+		})
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	code, stdout, _ := runCommand("20 123 456\n10123456\n", "validate", "phone", "--json")
+	if code != exitInvalid {
+		t.Errorf("exit code = %d, want %d", code, exitInvalid)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d JSON lines, want 2: %q", len(lines), stdout)
+	}
+
+	var valid, invalid validateResult
+	if err := json.Unmarshal([]byte(lines[0]), &valid); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &invalid); err != nil {
+		t.Fatal(err)
+	}
+	if !valid.Valid || valid.Normalized != "+21620123456" || valid.Metadata["carrier"] != "Ooredoo Tunisia" {
+		t.Errorf("valid result = %+v", valid)
+	}
+	if invalid.Valid || invalid.Error == nil || invalid.Error.Code != "bad_prefix" {
+		t.Errorf("invalid result = %+v", invalid)
+	}
+
+	_, stdout, _ = runCommand("", "format", "phone", "--json", "10123456")
+	var result formatResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil || result.Error == "" {
+		t.Errorf("format --json output = %q, want an error object", stdout)
+	}
+
+	_, stdout, _ = runCommand("", "info", "bank", "--all", "--json")
+	if n := strings.Count(stdout, "\n"); n < 10 {
+		t.Errorf("info bank --all listed %d banks", n)
+	}
+}
+
+func TestServe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("cannot listen:", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	code := make(chan int, 1)
+	go func() {
+		code <- serve(ctx, &env{stdout: io.Discard, stderr: io.Discard}, listener, server.Config{})
+	}()
+
+	resp, err := http.Get("http://" + listener.Addr().String() + "/v1/validate/cin?value=12345678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), `"valid":true`) {
+		t.Errorf("GET /v1/validate/cin = %d %s", resp.StatusCode, body)
+	}
+
+	cancel()
+	if c := <-code; c != exitOK {
+		t.Errorf("serve exit code = %d, want %d", c, exitOK)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"strings"
+
+	"github.com/degache-go/degache/schema"
+)
+
+// runPatterns implements "degache patterns"
+func runPatterns(e *env, args []string) int {
+	fs := newFlagSet(e, "patterns", patternsUsage)
+	asJSON := fs.Bool("json", false, "write one JSON object per line")
+	asJS := fs.Bool("js", false, "write a JavaScript module exporting the patterns")
+
+	names, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	if *asJS {
+		module, err := schema.ESModule(names...)
+		if err != nil {
+			return exitCode(e, false, err)
+		}
+		_, err = e.stdout.Write(module)
+		return exitCode(e, false, err)
+	}
+
+	regexes, err := schema.ECMAScript(names...)
+	if err != nil {
+		return exitCode(e, false, err)
+	}
+	out := newOutput(e, *asJSON)
+	for _, r := range regexes {
+		out.write(r, r.Name, r.Literal, strings.Join(r.Masks, " | "))
+	}
+	return exitOK
+}
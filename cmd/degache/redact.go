@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/redact"
+)
+
+// redactMatch is the JSON output of "degache redact --json"
+type redactMatch struct {
+	// Line is the line number of the match in standard input, or the index of the argument, from 1
+	Line int `json:"line"`
+	redact.Match
+}
+
+// runRedact implements "degache redact"
+func runRedact(e *env, args []string) int {
+	fs := newFlagSet(e, "redact", redactUsage)
+	asJSON := fs.Bool("json", false, "write the identifiers found as JSON lines instead of the redacted text")
+	mask := fs.Bool("mask", false, "replace identifiers with their masked form instead of a placeholder")
+	invalid := fs.Bool("invalid", false, "also replace numbers laid out like an identifier but invalid")
+	minConfidence := fs.Float64("min-confidence", 0, "ignore identifiers with a lower confidence (0 to 1)")
+	kinds := fs.String("kinds", "", "comma-separated kinds to find: "+strings.Join(redact.Kinds(), ", ")+" (default all)")
+
+	texts, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	opts := redact.Options{IncludeInvalid: *invalid, MinConfidence: *minConfidence}
+	if *mask {
+		opts.Replace = redact.Mask
+	}
+	if *kinds != "" {
+		opts.Kinds = strings.Split(*kinds, ",")
+	}
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintf(e.stderr, "degache: %v\n", err)
+		return exitUsage
+	}
+
+	out := newOutput(e, *asJSON)
+	line := 0
+	redactText := func(text string) {
+		line++
+		if !*asJSON {
+			fmt.Fprintln(e.stdout, redact.Redact(text, opts))
+			return
+		}
+		for _, m := range redact.Scan(text, opts) {
+			out.write(redactMatch{Line: line, Match: m})
+		}
+	}
+
+	if len(texts) > 0 {
+		for _, text := range texts {
+			redactText(text)
+		}
+		return exitOK
+	}
+
+	// Unlike eachInput, keep blank lines so that the output matches the input line for line
+	scanner := bufio.NewScanner(e.stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		redactText(scanner.Text())
+	}
+	return exitCode(e, false, scanner.Err())
+}
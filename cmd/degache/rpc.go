@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/degache-go/degache/jsonrpc"
+)
+
+// runRPC implements "degache rpc"
+func runRPC(e *env, args []string) int {
+	fs := newFlagSet(e, "rpc", rpcUsage)
+	list := fs.Bool("methods", false, "list the methods and their parameters, then exit")
+
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	srv := jsonrpc.NewServer()
+	if *list {
+		for _, m := range srv.Methods() {
+			fmt.Fprintln(e.stdout, m.Name, m.Params)
+		}
+		return exitOK
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := srv.Serve(ctx, e.stdin, e.stdout); err != nil {
+		fmt.Fprintln(e.stderr, "degache:", err)
+		return exitUsage
+	}
+	return exitOK
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/schema"
+)
+
+// runSchema implements "degache schema"
+func runSchema(e *env, args []string) int {
+	fs := newFlagSet(e, "schema", schemaUsage)
+	lang := fs.String("lang", "en", "language of the titles and descriptions: en, fr or ar")
+	openAPI := fs.Bool("openapi", false, "write OpenAPI components instead of a JSON Schema document")
+
+	names, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	generate := schema.JSONSchema
+	if *openAPI {
+		generate = schema.OpenAPI
+	}
+	doc, err := generate(i18n.ParseLocale(*lang), names...)
+	if err != nil {
+		return exitCode(e, false, err)
+	}
+	fmt.Fprintln(e.stdout, string(doc))
+	return exitOK
+}
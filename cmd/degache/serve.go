@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/degache-go/degache/server"
+)
+
+// shutdownTimeout is the time given to in-flight requests when the server stops
+const shutdownTimeout = 15 * time.Second
+
+// runServe implements "degache serve"
+func runServe(e *env, args []string) int {
+	fs := newFlagSet(e, "serve", serveUsage)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	maxBody := fs.Int64("max-body", server.DefaultMaxBodyBytes, "maximum size of a request body in bytes")
+	maxBatch := fs.Int("max-batch", server.DefaultMaxBatchSize, "maximum number of records or values of a batch request")
+
+	positional, err := parseArgs(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintln(e.stderr, "degache:", err)
+		return exitUsage
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return serve(ctx, e, listener, server.Config{MaxBodyBytes: *maxBody, MaxBatchSize: *maxBatch})
+}
+
+// serve serves the API on listener until ctx is done, then shuts down gracefully
+func serve(ctx context.Context, e *env, listener net.Listener, config server.Config) int {
+	srv := server.New(config)
+	httpServer := &http.Server{
+		Handler:           srv,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+	fmt.Fprintln(e.stderr, "degache: listening on", listener.Addr())
+
+	select {
+	case err := <-errs:
+		fmt.Fprintln(e.stderr, "degache:", err)
+		return exitUsage
+	case <-ctx.Done():
+	}
+
+	srv.SetReady(false)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		fmt.Fprintln(e.stderr, "degache:", err)
+		return exitUsage
+	}
+	return exitOK
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// validateKinds maps the kinds accepted by "validate" to validator names
+var validateKinds = map[string]string{
+	"cin":    "cin",
+	"phone":  "phone",
+	"rib":    "rib",
+	"taxid":  "taxID",
+	"plate":  "carPlate",
+	"postal": "postal",
+}
+
+// validateResult is the JSON output of "validate" for one value
+type validateResult struct {
+	Value string `json:"value"`
+	types.FieldReport
+	// Message is the localized error message, set with --lang
+	Message string `json:"message,omitempty"`
+}
+
+// runValidate implements "degache validate"
+func runValidate(e *env, args []string) int {
+	fs := newFlagSet(e, "validate", validateUsage)
+	asJSON := fs.Bool("json", false, "write one JSON object per value")
+	strict := fs.Bool("strict", false, "use strict validation (no normalization, exact spacing)")
+	plateType := fs.String("type", "", "car plate type: standard or special")
+	lang := fs.String("lang", "", "language of the error messages: en, fr or ar")
+
+	kind, values, code, ok := parseCommand(fs, args)
+	if !ok {
+		return code
+	}
+
+	name, known := validateKinds[kind]
+	if !known {
+		name = kind
+	}
+	if _, registered := validators.Lookup(name); !registered {
+		fmt.Fprintf(e.stderr, "degache: unknown kind %q\n", kind)
+		fs.Usage()
+		return exitUsage
+	}
+
+	opts := validators.Options{Strict: *strict}
+	if *plateType != "" {
+		opts.Params = map[string]string{"type": *plateType}
+	}
+
+	out := newOutput(e, *asJSON)
+	invalid := false
+	err := e.eachInput(values, func(value string) {
+		report := validators.DefaultRegistry.Report(map[string]string{name: value}, map[string]validators.Options{name: opts})
+		result := validateResult{Value: value, FieldReport: report.Fields[name]}
+		if result.Valid {
+			out.write(result, value, "valid")
+			return
+		}
+
+		invalid = true
+		message := result.Error.Error()
+		if *lang != "" {
+			message = i18n.Message(result.Error, i18n.ParseLocale(*lang))
+			result.Message = message
+		}
+		out.write(result, value, "invalid", message)
+	})
+
+	return exitCode(e, invalid, err)
+}
@@ -0,0 +1,297 @@
+// Package csvclean validates and cleans CSV files of Tunisian data as a stream.
+//
+// Each configured column is checked with a validator of a validators.Registry
+// and, when valid, rewritten in its canonical form (e.g. phone numbers with
+// formatters.NormalizePhoneNumber). Valid rows are written to a clean CSV and
+// invalid rows, unchanged, to a rejects CSV with their line number and the
+// reasons of the rejection. Rows are processed one at a time, so files larger
+// than memory can be cleaned.
+//
+// Example usage:
+//
+//	cleaner, err := csvclean.NewCleaner(csvclean.Config{
+//	    Columns: []csvclean.Column{
+//	        {Name: "CIN", Validator: "cin", Required: true},
+//	        {Name: "Téléphone", Validator: "phone"},
+//	        {Name: "RIB", Validator: "rib"},
+//	    },
+//	})
+//	stats, err := cleaner.Clean(ctx, input, cleanFile, rejectsFile)
+package csvclean
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/degache-go/degache/formatters"
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// Column maps a CSV column to a validator
+type Column struct {
+	// Name is the column header (matched case-insensitively)
+	Name string
+	// Validator is the name of the validator in the registry (e.g. "cin", "phone")
+	Validator string
+	// Options are passed to the validator
+	Options validators.Options
+	// Required rejects empty values; empty values of optional columns are kept as is
+	Required bool
+	// Normalize rewrites a valid value; nil selects the default normalizer of the validator
+	Normalize func(value string) (string, error)
+}
+
+// Config configures a Cleaner
+type Config struct {
+	// Columns lists the validated columns; other columns are copied unchanged
+	Columns []Column
+	// Comma is the field delimiter (default ',')
+	Comma rune
+	// Registry provides the validators (default validators.DefaultRegistry)
+	Registry *validators.Registry
+	// Locale selects the language of the rejection reasons (default: the validator messages)
+	Locale i18n.Locale
+}
+
+// Stats summarizes a cleaning run
+type Stats struct {
+	// Rows is the number of data rows read
+	Rows int `json:"rows"`
+	// Clean is the number of rows written to the clean output
+	Clean int `json:"clean"`
+	// Rejected is the number of rows written to the rejects output
+	Rejected int `json:"rejected"`
+	// ColumnErrors counts the invalid values of each column
+	ColumnErrors map[string]int `json:"columnErrors"`
+}
+
+// Cleaner validates and cleans CSV streams
+type Cleaner struct {
+	config  Config
+	columns []cleanColumn
+}
+
+// cleanColumn is a configured column with its validator resolved
+type cleanColumn struct {
+	Column
+	validator validators.Validator
+}
+
+// NewCleaner creates a Cleaner
+//
+// Parameters:
+//   - config: The columns to validate and the CSV settings
+//
+// Returns:
+//   - *Cleaner: the cleaner
+//   - error: an error wrapping validators.ErrUnknownValidator if a column uses an unknown validator
+func NewCleaner(config Config) (*Cleaner, error) {
+	if config.Comma == 0 {
+		config.Comma = ','
+	}
+	if config.Registry == nil {
+		config.Registry = validators.DefaultRegistry
+	}
+
+	c := &Cleaner{config: config}
+	for _, column := range config.Columns {
+		v, ok := config.Registry.Lookup(column.Validator)
+		if !ok {
+			return nil, fmt.Errorf("csvclean: column %q: %w: %q", column.Name, validators.ErrUnknownValidator, column.Validator)
+		}
+		if column.Normalize == nil {
+			column.Normalize = defaultNormalizer(v, column.Options)
+		}
+		c.columns = append(c.columns, cleanColumn{Column: column, validator: v})
+	}
+
+	return c, nil
+}
+
+// Clean reads CSV rows from in and writes valid rows, normalized, to clean and
+// invalid rows to rejects. The first row must be the header. The rejects
+// output repeats the header followed by "line" and "reason" columns; it may be
+// nil to discard rejected rows.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops the run
+//   - in: The CSV input
+//   - clean: The output of valid rows
+//   - rejects: The output of invalid rows (optional)
+//
+// Returns:
+//   - Stats: the number of rows read, cleaned and rejected
+//   - error: a read, write or header error, or ctx.Err()
+func (c *Cleaner) Clean(ctx context.Context, in io.Reader, clean, rejects io.Writer) (Stats, error) {
+	stats := Stats{ColumnErrors: make(map[string]int)}
+
+	reader := csv.NewReader(in)
+	reader.Comma = c.config.Comma
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	cleanWriter := csv.NewWriter(clean)
+	cleanWriter.Comma = c.config.Comma
+	if rejects == nil {
+		rejects = io.Discard
+	}
+	rejectsWriter := csv.NewWriter(rejects)
+	rejectsWriter.Comma = c.config.Comma
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return stats, errors.New("csvclean: missing header")
+		}
+		return stats, fmt.Errorf("csvclean: %w", err)
+	}
+	header = append([]string(nil), header...)
+	header[0] = strings.TrimPrefix(header[0], "\ufeff") // byte order mark written by spreadsheet applications
+
+	indexes, err := c.columnIndexes(header)
+	if err != nil {
+		return stats, err
+	}
+
+	if err := cleanWriter.Write(header); err != nil {
+		return stats, fmt.Errorf("csvclean: %w", err)
+	}
+	if err := rejectsWriter.Write(append(header[:len(header):len(header)], "line", "reason")); err != nil {
+		return stats, fmt.Errorf("csvclean: %w", err)
+	}
+
+	cleaned := make([]string, len(header))
+	var reasons []string
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stats, fmt.Errorf("csvclean: %w", err)
+		}
+		stats.Rows++
+		line, _ := reader.FieldPos(0)
+
+		reasons = reasons[:0]
+		if len(record) != len(header) {
+			reasons = append(reasons, fmt.Sprintf("expected %d fields, got %d", len(header), len(record)))
+		} else {
+			copy(cleaned, record)
+			for i, column := range c.columns {
+				value, reason := c.cleanValue(column, record[indexes[i]])
+				if reason != "" {
+					stats.ColumnErrors[column.Name]++
+					reasons = append(reasons, column.Name+": "+reason)
+					continue
+				}
+				cleaned[indexes[i]] = value
+			}
+		}
+
+		if len(reasons) > 0 {
+			stats.Rejected++
+			row := append(record, strconv.Itoa(line), strings.Join(reasons, "; "))
+			if err := rejectsWriter.Write(row); err != nil {
+				return stats, fmt.Errorf("csvclean: %w", err)
+			}
+			continue
+		}
+
+		stats.Clean++
+		if err := cleanWriter.Write(cleaned); err != nil {
+			return stats, fmt.Errorf("csvclean: %w", err)
+		}
+	}
+
+	cleanWriter.Flush()
+	rejectsWriter.Flush()
+	if err := errors.Join(cleanWriter.Error(), rejectsWriter.Error()); err != nil {
+		return stats, fmt.Errorf("csvclean: %w", err)
+	}
+	return stats, nil
+}
+
+// columnIndexes returns the position in header of every configured column
+func (c *Cleaner) columnIndexes(header []string) ([]int, error) {
+	indexes := make([]int, len(c.columns))
+	for i, column := range c.columns {
+		indexes[i] = -1
+		for j, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column.Name) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return nil, fmt.Errorf("csvclean: column %q not found in header", column.Name)
+		}
+	}
+	return indexes, nil
+}
+
+// cleanValue validates and normalizes a value, returning the reason of the rejection if it is invalid
+func (c *Cleaner) cleanValue(column cleanColumn, value string) (string, string) {
+	if strings.TrimSpace(value) == "" && !column.Required {
+		return value, ""
+	}
+
+	if err := column.validator.Check(value, column.Options); err != nil {
+		if c.config.Locale != "" {
+			return "", i18n.Message(err, c.config.Locale)
+		}
+		return "", err.Error()
+	}
+
+	normalized, err := column.Normalize(value)
+	if err != nil {
+		return "", err.Error()
+	}
+	return normalized, ""
+}
+
+// defaultNormalizer returns the normalizer of a built-in validator: the
+// formatters and Parse constructors write the canonical form of the value.
+// Other validators are normalized with their Normalizer implementation, if any.
+func defaultNormalizer(v validators.Validator, opts validators.Options) func(string) (string, error) {
+	switch v.Name() {
+	case "phone":
+		return formatters.NormalizePhoneNumber
+	case "cin":
+		return parseWith(types.ParseCIN)
+	case "taxID":
+		return parseWith(types.ParseTaxID)
+	case "rib":
+		return parseWith(types.ParseRIB)
+	case "postal":
+		return parseWith(types.ParsePostalCode)
+	}
+
+	if n, ok := v.(validators.Normalizer); ok {
+		return func(value string) (string, error) {
+			return n.Normalize(value, opts)
+		}
+	}
+	return func(value string) (string, error) {
+		return value, nil
+	}
+}
+
+// parseWith adapts a Parse constructor of package types to a normalizer
+func parseWith[T ~string](parse func(string) (T, error)) func(string) (string, error) {
+	return func(value string) (string, error) {
+		parsed, err := parse(value)
+		return string(parsed), err
+	}
+}
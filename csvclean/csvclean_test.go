@@ -0,0 +1,149 @@
+package csvclean
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/validators"
+)
+
+func newTestCleaner(t *testing.T, config Config) *Cleaner {
+	t.Helper()
+	if config.Columns == nil {
+		config.Columns = []Column{
+			{Name: "cin", Validator: "cin", Required: true},
+			{Name: "phone", Validator: "phone"},
+			{Name: "rib", Validator: "rib"},
+			{Name: "postal", Validator: "postal"},
+		}
+	}
+
+	cleaner, err := NewCleaner(config)
+	if err != nil {
+		t.Fatalf("NewCleaner() unexpected error: %v", err)
+	}
+	return cleaner
+}
+
+func TestClean(t *testing.T) {
+	input := "\ufeffname,CIN,Phone,RIB,Postal\n" +
+		"Amine,12345678,+216 20 123 456,01234567890123456789,1000\n" +
+		"Sami,١٢٣٤٥٦٧٨,٩٨ ١٢٣ ٤٥٦,,\n" +
+		"Leila,22345678,10123456,01234567890123456789,1000\n" +
+		"Nour,,20123456,01234567890123456789,1000\n" +
+		"Short,12345678\n"
+
+	var clean, rejects bytes.Buffer
+	stats, err := newTestCleaner(t, Config{}).Clean(context.Background(), strings.NewReader(input), &clean, &rejects)
+	if err != nil {
+		t.Fatalf("Clean() unexpected error: %v", err)
+	}
+
+	wantClean := "name,CIN,Phone,RIB,Postal\n" +
+		"Amine,12345678,20123456,01234567890123456789,1000\n" +
+		"Sami,12345678,98123456,,\n"
+	if clean.String() != wantClean {
+		t.Errorf("clean output =\n%s\nwant\n%s", clean.String(), wantClean)
+	}
+
+	wantRejects := "name,CIN,Phone,RIB,Postal,line,reason\n" +
+		"Leila,22345678,10123456,01234567890123456789,1000,4,cin: CIN must start with 0 or 1 and contain only digits; phone: Phone number must start with 2-9 and contain only digits\n" +
+		"Nour,,20123456,01234567890123456789,1000,5,cin: CIN cannot be empty\n" +
+		"Short,12345678,6,\"expected 5 fields, got 2\"\n"
+	if rejects.String() != wantRejects {
+		t.Errorf("rejects output =\n%s\nwant\n%s", rejects.String(), wantRejects)
+	}
+
+	if stats.Rows != 5 || stats.Clean != 2 || stats.Rejected != 3 {
+		t.Errorf("Clean() stats = %+v, want 5 rows, 2 clean, 3 rejected", stats)
+	}
+	if stats.ColumnErrors["cin"] != 2 || stats.ColumnErrors["phone"] != 1 {
+		t.Errorf("ColumnErrors = %v, want cin:2 phone:1", stats.ColumnErrors)
+	}
+}
+
+func TestCleanOptions(t *testing.T) {
+	cleaner := newTestCleaner(t, Config{
+		Columns: []Column{
+			{Name: "phone", Validator: "phone", Options: validators.Options{Strict: true}},
+			{Name: "plate", Validator: "carPlate"},
+		},
+		Comma:  ';',
+		Locale: i18n.French,
+	})
+
+	input := "phone;plate\n20 123 456;123  تونس 4567\n+21620123456;123  تونس 4567\n"
+	var clean, rejects bytes.Buffer
+	if _, err := cleaner.Clean(context.Background(), strings.NewReader(input), &clean, &rejects); err != nil {
+		t.Fatalf("Clean() unexpected error: %v", err)
+	}
+
+	if want := "phone;plate\n20123456;123 تونس 4567\n"; clean.String() != want {
+		t.Errorf("clean output = %q, want %q", clean.String(), want)
+	}
+	if !strings.Contains(rejects.String(), "Le numéro de téléphone doit comporter 8 chiffres") {
+		t.Errorf("rejects output = %q, want a French reason", rejects.String())
+	}
+}
+
+func TestCleanErrors(t *testing.T) {
+	if _, err := NewCleaner(Config{Columns: []Column{{Name: "x", Validator: "unknown"}}}); !errors.Is(err, validators.ErrUnknownValidator) {
+		t.Errorf("NewCleaner() error = %v, want ErrUnknownValidator", err)
+	}
+
+	cleaner := newTestCleaner(t, Config{})
+	if _, err := cleaner.Clean(context.Background(), strings.NewReader(""), io.Discard, nil); err == nil {
+		t.Error("Clean() of an empty input should fail")
+	}
+	if _, err := cleaner.Clean(context.Background(), strings.NewReader("cin,phone\n"), io.Discard, nil); err == nil {
+		t.Error("Clean() with missing columns should fail")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	input := "cin,phone,rib,postal\n12345678,,,\n"
+	if _, err := cleaner.Clean(ctx, strings.NewReader(input), io.Discard, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Clean() error = %v, want context.Canceled", err)
+	}
+}
+
+// rowReader generates an endless CSV stream of n rows without holding it in memory
+type rowReader struct {
+	n    int
+	line []byte
+	buf  []byte
+}
+
+func (r *rowReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.n == 0 {
+			return 0, io.EOF
+		}
+		r.n--
+		r.buf = r.line
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func TestCleanStreams(t *testing.T) {
+	const rows = 100000
+	in := io.MultiReader(
+		strings.NewReader("cin,phone,rib,postal\n"),
+		&rowReader{n: rows, line: []byte("12345678,20 123 456,01234567890123456789,1000\n")},
+	)
+
+	stats, err := newTestCleaner(t, Config{}).Clean(context.Background(), in, io.Discard, nil)
+	if err != nil {
+		t.Fatalf("Clean() unexpected error: %v", err)
+	}
+	if stats.Rows != rows || stats.Clean != rows {
+		t.Errorf("Clean() stats = %+v, want %d clean rows", stats, rows)
+	}
+}
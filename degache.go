@@ -20,6 +20,8 @@
 package degache
 
 import (
+	"errors"
+
 	"github.com/degache-go/degache/constants"
 	"github.com/degache-go/degache/formatters"
 	"github.com/degache-go/degache/types"
@@ -50,6 +52,75 @@ var (
 	ValidateCarPlate = validators.ValidateCarPlate
 )
 
+// Re-export structured validation functions for convenience
+var (
+	// CheckCIN validates a CIN and returns a *ValidationError on failure
+	CheckCIN = validators.CheckCIN
+
+	// CheckPhoneNumber validates a phone number and returns a *ValidationError on failure
+	CheckPhoneNumber = validators.CheckPhoneNumber
+
+	// CheckTaxID validates a Tax ID and returns a *ValidationError on failure
+	CheckTaxID = validators.CheckTaxID
+
+	// CheckRIB validates a RIB and returns a *ValidationError on failure
+	CheckRIB = validators.CheckRIB
+
+	// CheckPostalCode validates a postal code and returns a *ValidationError on failure
+	CheckPostalCode = validators.CheckPostalCode
+
+	// CheckCarPlate validates a car plate and returns a *ValidationError on failure
+	CheckCarPlate = validators.CheckCarPlate
+)
+
+// Re-export validation error codes for use with errors.Is
+const (
+	ErrEmpty            = types.ErrEmpty
+	ErrTooShort         = types.ErrTooShort
+	ErrTooLong          = types.ErrTooLong
+	ErrInvalidCharacter = types.ErrInvalidCharacter
+	ErrBadPrefix        = types.ErrBadPrefix
+	ErrUnknownBank      = types.ErrUnknownBank
+	ErrChecksum         = types.ErrChecksum
+	ErrBadFormat        = types.ErrBadFormat
+	ErrUnsupportedType  = types.ErrUnsupportedType
+	ErrUnknownField     = types.ErrUnknownField
+	ErrInvalid          = types.ErrInvalid
+)
+
+// Re-export the validator registry for convenience
+var (
+	// RegisterValidator adds a validator to the default registry
+	RegisterValidator = validators.Register
+
+	// NewValidator creates a Validator from a name and a check function
+	NewValidator = validators.NewValidator
+
+	// ValidateStruct validates the fields of a struct using `degache` struct tags
+	ValidateStruct = validators.ValidateStruct
+
+	// ValidateBatch validates records concurrently with a bounded pool of workers
+	ValidateBatch = validators.ValidateBatch
+)
+
+// Re-export parse constructors for convenience
+var (
+	// ParseCIN parses and validates a CIN
+	ParseCIN = types.ParseCIN
+
+	// ParsePhoneNumber parses a phone number into its national form
+	ParsePhoneNumber = types.ParsePhoneNumber
+
+	// ParseTaxID parses and validates a Tax ID
+	ParseTaxID = types.ParseTaxID
+
+	// ParseRIB parses and validates a RIB
+	ParseRIB = types.ParseRIB
+
+	// ParsePostalCode parses and validates a postal code
+	ParsePostalCode = types.ParsePostalCode
+)
+
 // Re-export commonly used formatters for convenience
 var (
 	// FormatPhoneNumber formats a Tunisian phone number
@@ -64,6 +135,9 @@ var (
 
 // Re-export commonly used functions for convenience
 var (
+	// Normalize converts Arabic-Indic digits, invisible marks and Unicode spaces to plain ASCII
+	Normalize = validators.Normalize
+
 	// GetCarrierInfo gets carrier information from a phone number
 	GetCarrierInfo = validators.GetCarrierInfo
 
@@ -132,11 +206,39 @@ type (
 
 	// Address represents a Tunisian address
 	Address = types.Address
+
+	// ValidationOptions contains options shared by the CIN, Tax ID, RIB and postal code validators
+	ValidationOptions = types.ValidationOptions
+
+	// ValidationError describes why a value failed validation
+	ValidationError = types.ValidationError
+
+	// ErrorCode is a stable machine-readable validation failure reason
+	ErrorCode = types.ErrorCode
+
+	// Validator validates one kind of Tunisian data
+	Validator = validators.Validator
+
+	// ValidatorOptions contains per-field options passed to a Validator
+	ValidatorOptions = validators.Options
+
+	// ValidationReport describes the validation outcome of a multi-field record
+	ValidationReport = types.ValidationReport
+
+	// FieldReport describes the validation outcome of a single field
+	FieldReport = types.FieldReport
+
+	// BatchOptions configures ValidateBatch
+	BatchOptions = validators.BatchOptions
 )
 
 // Utility functions
 
 // IsValidTunisianData performs comprehensive validation of common Tunisian data
+// Each key is validated with the validator registered under that name in
+// validators.DefaultRegistry (built-ins: cin, phone, taxID, rib, postal, carPlate).
+// Keys without a registered validator are left out of the results; use
+// CheckTunisianData to get an error for them.
 //
 // Parameters:
 //   - data: map containing the data to validate
@@ -152,31 +254,70 @@ type (
 //	    "taxID": "1234567A/P/M/000",
 //	})
 func IsValidTunisianData(data map[string]string) map[string]bool {
-	results := make(map[string]bool)
-
-	if cin, exists := data["cin"]; exists {
-		results["cin"] = ValidateCIN(cin)
-	}
-
-	if phone, exists := data["phone"]; exists {
-		results["phone"] = ValidatePhoneNumber(phone)
-	}
+	return IsValidTunisianDataWithOptions(data, nil)
+}
 
-	if taxID, exists := data["taxID"]; exists {
-		results["taxID"] = ValidateTaxID(taxID)
-	}
+// IsValidTunisianDataWithOptions is like IsValidTunisianData with per-field options
+//
+// Parameters:
+//   - data: map containing the data to validate
+//   - options: validation options per field (may be nil)
+//
+// Returns:
+//   - map[string]bool: validation results for each field
+//
+// Example:
+//
+//	results := IsValidTunisianDataWithOptions(data, map[string]ValidatorOptions{
+//	    "phone":    {Strict: true},
+//	    "carPlate": {Params: map[string]string{"type": "special"}},
+//	})
+func IsValidTunisianDataWithOptions(data map[string]string, options map[string]ValidatorOptions) map[string]bool {
+	results := make(map[string]bool)
 
-	if rib, exists := data["rib"]; exists {
-		results["rib"] = ValidateRIB(rib)
+	for field, err := range CheckTunisianData(data, options) {
+		if errors.Is(err, validators.ErrUnknownValidator) {
+			continue
+		}
+		results[field] = err == nil
 	}
 
-	if postal, exists := data["postal"]; exists {
-		results["postal"] = ValidatePostalCode(postal)
-	}
+	return results
+}
 
-	if plate, exists := data["carPlate"]; exists {
-		results["carPlate"] = ValidateCarPlate(plate)
-	}
+// CheckTunisianData validates every field of data with the registered validators
+//
+// Parameters:
+//   - data: map containing the data to validate
+//   - options: validation options per field (may be nil)
+//
+// Returns:
+//   - map[string]error: nil for valid fields, the validation error otherwise.
+//     Fields without a registered validator map to an error wrapping validators.ErrUnknownValidator.
+func CheckTunisianData(data map[string]string, options map[string]ValidatorOptions) map[string]error {
+	return validators.DefaultRegistry.CheckAll(data, options)
+}
 
-	return results
+// ValidateTunisianData validates every field of data and returns a report with,
+// for each field, its validity, the structured reason of a failure, the normalized
+// value and extracted metadata (carrier, bank, governorate, ...).
+// The report can be serialized to JSON as is.
+//
+// Parameters:
+//   - data: map containing the data to validate
+//   - options: validation options per field (may be nil)
+//
+// Returns:
+//   - ValidationReport: the report
+//
+// Example:
+//
+//	report := ValidateTunisianData(map[string]string{
+//	    "phone": "20 123 456",
+//	    "rib":   "01234567890123456789",
+//	}, nil)
+//	fmt.Println(report.Fields["phone"].Normalized)       // +21620123456
+//	fmt.Println(report.Fields["rib"].Metadata["bank"])   // Banque Centrale de Tunisie
+func ValidateTunisianData(data map[string]string, options map[string]ValidatorOptions) ValidationReport {
+	return validators.DefaultRegistry.Report(data, options)
 }
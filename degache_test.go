@@ -1,6 +1,13 @@
 package degache
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/validators"
+)
 
 func TestMainPackageExports(t *testing.T) {
 	// Test that all main package exports work correctly
@@ -132,6 +139,90 @@ func TestIsValidTunisianData(t *testing.T) {
 	}
 }
 
+func TestIsValidTunisianDataWithRegisteredValidator(t *testing.T) {
+	err := RegisterValidator(NewValidator("employeeNumber", func(value string, _ ValidatorOptions) error {
+		if !strings.HasPrefix(value, "EMP-") {
+			return errors.New("employee numbers start with EMP-")
+		}
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("RegisterValidator() unexpected error: %v", err)
+	}
+	t.Cleanup(func() { validators.DefaultRegistry.Unregister("employeeNumber") })
+
+	results := IsValidTunisianDataWithOptions(map[string]string{
+		"employeeNumber": "EMP-0042",
+		"phone":          "20 123 456",
+		"nickname":       "degache",
+	}, map[string]ValidatorOptions{
+		"phone": {Strict: true},
+	})
+
+	if !results["employeeNumber"] {
+		t.Error("employeeNumber should be validated by the registered validator")
+	}
+	if results["phone"] {
+		t.Error("phone should be invalid in strict mode")
+	}
+	if _, exists := results["nickname"]; exists {
+		t.Error("fields without a registered validator should be left out")
+	}
+}
+
+func TestValidateTunisianData(t *testing.T) {
+	report := ValidateTunisianData(map[string]string{
+		"cin":      "2234567",
+		"phone":    "20 123 456",
+		"rib":      "01234567890123456789",
+		"postal":   "3000",
+		"carPlate": "123   تونس 4567",
+		"nickname": "degache",
+	}, nil)
+
+	if report.Valid {
+		t.Error("report should be invalid when a field is invalid")
+	}
+
+	cin := report.Fields["cin"]
+	if cin.Valid || cin.Error == nil || cin.Error.Code != ErrTooShort {
+		t.Errorf("cin report = %+v, want too_short error", cin)
+	}
+
+	phone := report.Fields["phone"]
+	if !phone.Valid || phone.Normalized != "+21620123456" || phone.Metadata["carrier"] != "Ooredoo Tunisia" {
+		t.Errorf("phone report = %+v", phone)
+	}
+
+	if bank := report.Fields["rib"].Metadata["bank"]; bank != "Banque Centrale de Tunisie" {
+		t.Errorf("rib bank = %q, want Banque Centrale de Tunisie", bank)
+	}
+
+	if governorate := report.Fields["postal"].Metadata["governorate"]; governorate != "Sfax" {
+		t.Errorf("postal governorate = %q, want Sfax", governorate)
+	}
+
+	if plate := report.Fields["carPlate"].Normalized; plate != "123 تونس 4567" {
+		t.Errorf("carPlate normalized = %q, want single spaces", plate)
+	}
+
+	if code := report.Fields["nickname"].Error.Code; code != ErrUnknownField {
+		t.Errorf("nickname code = %q, want %q", code, ErrUnknownField)
+	}
+
+	encoded, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("json.Marshal(report) unexpected error: %v", err)
+	}
+	var decoded ValidationReport
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(report) unexpected error: %v", err)
+	}
+	if decoded.Fields["cin"].Error.Code != ErrTooShort {
+		t.Errorf("decoded cin code = %q, want %q", decoded.Fields["cin"].Error.Code, ErrTooShort)
+	}
+}
+
 func BenchmarkMainPackageValidation(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		ValidateCIN("12345678")
@@ -0,0 +1,198 @@
+package degachetest
+
+import (
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// Case is a golden input of a validator and its expected outcome
+type Case struct {
+	// Name describes the case
+	Name string `json:"name"`
+	// Input is the value to validate
+	Input string `json:"input"`
+	// Options are the validation options (strict mode, car plate type)
+	Options validators.Options `json:"options"`
+	// Edge marks unusual inputs: normalized digits, invisible marks, boundary lengths, strict mode
+	Edge bool `json:"edge,omitempty"`
+	// Code is the expected error code, empty if the input is valid
+	Code types.ErrorCode `json:"code,omitempty"`
+	// Normalized is the expected canonical form of a valid input
+	Normalized string `json:"normalized,omitempty"`
+}
+
+// Valid reports whether the input is expected to be valid
+func (c Case) Valid() bool {
+	return c.Code == ""
+}
+
+// strict and special are the options of strict cases and special car plates
+var (
+	strict  = validators.Options{Strict: true}
+	special = validators.Options{Params: map[string]string{"type": "special"}}
+)
+
+// corpus contains the cases of every validator of validators.DefaultRegistry
+var corpus = map[string][]Case{
+	"cin": {
+		{Name: "starting with 0", Input: "01234567", Normalized: "01234567"},
+		{Name: "starting with 1", Input: "12345678", Normalized: "12345678"},
+		{Name: "starting with 2", Input: "22345678", Code: types.ErrBadPrefix},
+		{Name: "starting with a letter", Input: "a2345678", Code: types.ErrBadPrefix},
+		{Name: "too short", Input: "1234567", Code: types.ErrTooShort},
+		{Name: "too long", Input: "123456789", Code: types.ErrTooLong},
+		{Name: "letter", Input: "1234567a", Code: types.ErrInvalidCharacter},
+		{Name: "dash", Input: "1234-567", Code: types.ErrInvalidCharacter},
+		{Name: "space", Input: "1234 5678", Code: types.ErrTooLong},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "Arabic-Indic digits", Input: "١٢٣٤٥٦٧٨", Edge: true, Normalized: "12345678"},
+		{Name: "fullwidth digits", Input: "１２３４５６７８", Edge: true, Normalized: "12345678"},
+		{Name: "right-to-left mark", Input: "\u200f12345678", Edge: true, Normalized: "12345678"},
+		{Name: "leading space", Input: " 12345678", Edge: true, Normalized: "12345678"},
+		{Name: "Arabic-Indic digits in strict mode", Input: "١٢٣٤٥٦٧٨", Options: strict, Edge: true, Code: types.ErrTooLong},
+		{Name: "leading space in strict mode", Input: " 12345678", Options: strict, Edge: true, Code: types.ErrTooLong},
+	},
+	"phone": {
+		{Name: "Ooredoo", Input: "20123456", Normalized: "+21620123456"},
+		{Name: "Ooredoo 5", Input: "50123456", Normalized: "+21650123456"},
+		{Name: "Orange", Input: "40123456", Normalized: "+21640123456"},
+		{Name: "Tunisie Telecom", Input: "90123456", Normalized: "+21690123456"},
+		{Name: "international prefix", Input: "+21620123456", Normalized: "+21620123456"},
+		{Name: "grouped digits", Input: "20 123 456", Normalized: "+21620123456"},
+		{Name: "international grouped", Input: "+216 20 123 456", Normalized: "+21620123456"},
+		{Name: "dashes", Input: "20-123-456", Normalized: "+21620123456"},
+		{Name: "starting with 1", Input: "10123456", Code: types.ErrBadPrefix},
+		{Name: "no carrier prefix", Input: "30123456", Code: types.ErrBadPrefix},
+		{Name: "international starting with 1", Input: "+21610123456", Code: types.ErrBadPrefix},
+		{Name: "too short", Input: "2012345", Code: types.ErrTooShort},
+		{Name: "too long", Input: "201234567", Code: types.ErrTooLong},
+		{Name: "international too long", Input: "+216201234567", Code: types.ErrTooLong},
+		{Name: "00 prefix", Input: "0021620123456", Code: types.ErrTooLong},
+		{Name: "letter", Input: "2012345a", Code: types.ErrTooShort},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "Arabic-Indic grouped digits", Input: "٢٠ ١٢٣ ٤٥٦", Edge: true, Normalized: "+21620123456"},
+		{Name: "right-to-left mark", Input: "\u200f98123456", Edge: true, Normalized: "+21698123456"},
+		{Name: "strict", Input: "20123456", Options: strict, Edge: true, Normalized: "+21620123456"},
+		{Name: "strict international", Input: "+21620123456", Options: strict, Edge: true, Normalized: "+21620123456"},
+		{Name: "strict grouped", Input: "20 123 456", Options: strict, Edge: true, Code: types.ErrBadFormat},
+		{Name: "strict dashes", Input: "20-123-456", Options: strict, Edge: true, Code: types.ErrBadFormat},
+		{Name: "strict no carrier prefix", Input: "30123456", Options: strict, Edge: true, Code: types.ErrBadPrefix},
+	},
+	"taxID": {
+		{Name: "valid", Input: "1234567A/P/M/000", Normalized: "1234567A/P/M/000"},
+		{Name: "lowercase key", Input: "1234567a/P/M/000", Code: types.ErrInvalidCharacter},
+		{Name: "digit instead of key", Input: "12345678/P/M/000", Code: types.ErrInvalidCharacter},
+		{Name: "letter in establishment", Input: "1234567A/P/M/00a", Code: types.ErrInvalidCharacter},
+		{Name: "dash separator", Input: "1234567A-P/M/000", Code: types.ErrBadFormat},
+		{Name: "6-digit number", Input: "123456A/P/M/000", Code: types.ErrTooShort},
+		{Name: "2-digit establishment", Input: "1234567A/P/M/00", Code: types.ErrTooShort},
+		{Name: "4-digit establishment", Input: "1234567A/P/M/0000", Code: types.ErrTooLong},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "Arabic-Indic digits", Input: "١٢٣٤٥٦٧A/P/M/000", Edge: true, Normalized: "1234567A/P/M/000"},
+		{Name: "Arabic-Indic digits in strict mode", Input: "١٢٣٤٥٦٧A/P/M/000", Options: strict, Edge: true, Code: types.ErrTooLong},
+	},
+	"rib": {
+		{Name: "Banque Centrale de Tunisie", Input: "01234567890123456789", Normalized: "01234567890123456789"},
+		{Name: "Amen Bank", Input: "20123456789012345678", Normalized: "20123456789012345678"},
+		{Name: "unknown bank 99", Input: "99234567890123456789", Code: types.ErrUnknownBank},
+		{Name: "unknown bank 06", Input: "06234567890123456789", Code: types.ErrUnknownBank},
+		{Name: "too short", Input: "0123456789012345678", Code: types.ErrTooShort},
+		{Name: "too long", Input: "012345678901234567890", Code: types.ErrTooLong},
+		{Name: "letter", Input: "0123456789012345678x", Code: types.ErrInvalidCharacter},
+		{Name: "grouped digits", Input: "01 234 5678901234567 89", Code: types.ErrTooLong},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "Arabic-Indic digits", Input: "٠١٢٣٤٥٦٧٨٩٠١٢٣٤٥٦٧٨٩", Edge: true, Normalized: "01234567890123456789"},
+		{Name: "Arabic-Indic digits in strict mode", Input: "٠١٢٣٤٥٦٧٨٩٠١٢٣٤٥٦٧٨٩", Options: strict, Edge: true, Code: types.ErrTooLong},
+	},
+	"postal": {
+		{Name: "Tunis", Input: "1000", Normalized: "1000"},
+		{Name: "Sfax", Input: "3000", Normalized: "3000"},
+		{Name: "not a governorate", Input: "9999", Normalized: "9999"},
+		{Name: "too short", Input: "100", Code: types.ErrTooShort},
+		{Name: "too long", Input: "10000", Code: types.ErrTooLong},
+		{Name: "letter", Input: "10a0", Code: types.ErrInvalidCharacter},
+		{Name: "space", Input: "10 00", Code: types.ErrTooLong},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "Persian digits", Input: "۱۰۰۰", Edge: true, Normalized: "1000"},
+		{Name: "leading space", Input: " 1000", Edge: true, Normalized: "1000"},
+		{Name: "leading space in strict mode", Input: " 1000", Options: strict, Edge: true, Code: types.ErrTooLong},
+	},
+	"carPlate": {
+		{Name: "standard", Input: "123 تونس 4567", Normalized: "123 تونس 4567"},
+		{Name: "special", Input: "RS 123 تونس", Options: special, Normalized: "RS 123 تونس"},
+		{Name: "special as standard", Input: "RS 123 تونس", Code: types.ErrBadFormat},
+		{Name: "standard as special", Input: "123 تونس 4567", Options: special, Code: types.ErrBadFormat},
+		{Name: "3-digit number", Input: "123 تونس 456", Code: types.ErrBadFormat},
+		{Name: "2-digit special", Input: "RS 12 تونس", Options: special, Code: types.ErrBadFormat},
+		{Name: "no region", Input: "123 4567", Code: types.ErrBadFormat},
+		{Name: "Latin region", Input: "123 TUN 4567", Code: types.ErrBadFormat},
+		{Name: "letters", Input: "abc تونس defg", Code: types.ErrBadFormat},
+		{Name: "empty", Input: "", Code: types.ErrEmpty},
+		{Name: "double spaces", Input: "123  تونس  4567", Edge: true, Normalized: "123 تونس 4567"},
+		{Name: "surrounding spaces", Input: " 123 تونس 4567 ", Edge: true, Normalized: "123 تونس 4567"},
+		{Name: "Arabic-Indic digits", Input: "١٢٣ تونس ٤٥٦٧", Edge: true, Normalized: "123 تونس 4567"},
+		{Name: "double spaces in strict mode", Input: "123  تونس  4567", Options: strict, Edge: true, Code: types.ErrBadFormat},
+	},
+}
+
+// Kinds returns the names of the validators that have a corpus
+func Kinds() []string {
+	kinds := make([]string, 0, len(corpus))
+	for kind := range corpus {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Cases returns the golden cases of a validator: valid, invalid and edge-case
+// inputs with their expected error code or canonical form
+//
+// Parameters:
+//   - kind: A validator name ("cin", "phone", "taxID", "rib", "postal", "carPlate"), case-insensitive
+//
+// Returns:
+//   - []Case: a copy of the cases, which the caller may modify, nil if kind has no corpus
+//
+// Example:
+//
+//	for _, c := range degachetest.Cases("phone") {
+//	    t.Run(c.Name, func(t *testing.T) { ... })
+//	}
+func Cases(kind string) []Case {
+	for name, cases := range corpus {
+		if strings.EqualFold(name, kind) {
+			copied := append([]Case(nil), cases...)
+			for i := range copied {
+				copied[i].Options.Params = maps.Clone(copied[i].Options.Params)
+			}
+			return copied
+		}
+	}
+	return nil
+}
+
+// ValidInputs returns the inputs of the valid cases of kind in default mode
+func ValidInputs(kind string) []string {
+	return inputs(kind, true)
+}
+
+// InvalidInputs returns the inputs of the invalid cases of kind in default mode
+func InvalidInputs(kind string) []string {
+	return inputs(kind, false)
+}
+
+// inputs returns the inputs of the cases without options that are valid or invalid
+func inputs(kind string, valid bool) []string {
+	var list []string
+	for _, c := range Cases(kind) {
+		if c.Valid() == valid && !c.Options.Strict && c.Options.Params == nil {
+			list = append(list, c.Input)
+		}
+	}
+	return list
+}
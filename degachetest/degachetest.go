@@ -0,0 +1,132 @@
+// Package degachetest helps test code that consumes degache: golden corpora of
+// valid, invalid and edge-case inputs for every identifier, testing.TB
+// assertion helpers and testing/quick generators.
+//
+// Example usage:
+//
+//	func TestSignupValidation(t *testing.T) {
+//	    degachetest.AssertCorpus(t, "phone", func(input string, opts validators.Options) error {
+//	        return signup.CheckPhone(input, opts.Strict)
+//	    })
+//	}
+//
+//	func TestStoreRoundTrip(t *testing.T) {
+//	    quick.Check(func(rib degachetest.ValidRIB) bool {
+//	        return store.Load(store.Save(types.RIB(rib))) == types.RIB(rib)
+//	    }, nil)
+//	}
+package degachetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// check validates value with the validator of validators.DefaultRegistry named kind
+func check(tb testing.TB, kind, value string, opts []validators.Options) error {
+	tb.Helper()
+	if _, ok := validators.DefaultRegistry.Lookup(kind); !ok {
+		tb.Fatalf("degachetest: no validator is registered for %q", kind)
+	}
+
+	var o validators.Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	return validators.DefaultRegistry.Check(kind, value, o)
+}
+
+// AssertValid reports an error if value is not a valid value of kind
+//
+// Parameters:
+//   - tb: The test
+//   - kind: A validator name of validators.DefaultRegistry (e.g. "rib")
+//   - value: The value to validate
+//   - opts: Validation options (optional)
+//
+// Example:
+//
+//	degachetest.AssertValid(t, "phone", customer.Phone)
+func AssertValid(tb testing.TB, kind, value string, opts ...validators.Options) {
+	tb.Helper()
+	if err := check(tb, kind, value, opts); err != nil {
+		tb.Errorf("%s %q is invalid: %v", kind, value, err)
+	}
+}
+
+// AssertInvalid reports an error if value is a valid value of kind, or if the
+// error code is not code
+//
+// Parameters:
+//   - tb: The test
+//   - kind: A validator name of validators.DefaultRegistry
+//   - value: The value to validate
+//   - code: The expected error code, or "" for any
+//   - opts: Validation options (optional)
+//
+// Example:
+//
+//	degachetest.AssertInvalid(t, "cin", "22345678", types.ErrBadPrefix)
+func AssertInvalid(tb testing.TB, kind, value string, code types.ErrorCode, opts ...validators.Options) {
+	tb.Helper()
+	err := check(tb, kind, value, opts)
+	if err == nil {
+		tb.Errorf("%s %q is valid, want an error", kind, value)
+		return
+	}
+	if code != "" {
+		AssertErrorCode(tb, err, code)
+	}
+}
+
+// AssertErrorCode reports an error unless err is, or wraps, a
+// *types.ValidationError with the given code
+//
+// Example:
+//
+//	_, err := formatters.FormatPhoneNumber("10123456")
+//	degachetest.AssertErrorCode(t, err, types.ErrBadPrefix)
+func AssertErrorCode(tb testing.TB, err error, code types.ErrorCode) {
+	tb.Helper()
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) {
+		tb.Errorf("error %v is not a *types.ValidationError with code %q", err, code)
+		return
+	}
+	if verr.Code != code {
+		tb.Errorf("error code = %q (%v), want %q", verr.Code, err, code)
+	}
+}
+
+// AssertCorpus runs every golden case of kind through check, a validation
+// function of the code under test, and reports the cases where it disagrees
+// with the corpus. An error that wraps a *types.ValidationError must have the
+// expected code; other errors only need to be non-nil for invalid inputs.
+//
+// Parameters:
+//   - tb: The test
+//   - kind: A validator name with a corpus (see Kinds)
+//   - check: The function under test, returning nil for valid inputs
+func AssertCorpus(tb testing.TB, kind string, check func(input string, opts validators.Options) error) {
+	tb.Helper()
+	cases := Cases(kind)
+	if cases == nil {
+		tb.Fatalf("degachetest: no corpus for %q", kind)
+	}
+
+	for _, c := range cases {
+		err := check(c.Input, c.Options)
+		var verr *types.ValidationError
+		switch {
+		case c.Valid() && err != nil:
+			tb.Errorf("%s/%s: %q returned %v, want nil", kind, c.Name, c.Input, err)
+		case !c.Valid() && err == nil:
+			tb.Errorf("%s/%s: %q returned nil, want an error with code %q", kind, c.Name, c.Input, c.Code)
+		case !c.Valid() && errors.As(err, &verr) && verr.Code != c.Code:
+			tb.Errorf("%s/%s: %q returned code %q (%v), want %q", kind, c.Name, c.Input, verr.Code, err, c.Code)
+		}
+	}
+}
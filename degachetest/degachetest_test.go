@@ -0,0 +1,126 @@
+package degachetest
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/degache-go/degache/fake"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// recorder is a testing.TB recording failures instead of failing the test
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// TestCorpusMatchesValidators checks every golden case, including the
+// canonical form of valid inputs, against validators.DefaultRegistry
+func TestCorpusMatchesValidators(t *testing.T) {
+	for _, kind := range Kinds() {
+		AssertCorpus(t, kind, func(input string, opts validators.Options) error {
+			return validators.DefaultRegistry.Check(kind, input, opts)
+		})
+
+		for _, c := range Cases(kind) {
+			report := validators.DefaultRegistry.Report(map[string]string{kind: c.Input},
+				map[string]validators.Options{kind: c.Options}).Fields[kind]
+			if report.Normalized != c.Normalized {
+				t.Errorf("%s/%s: normalized = %q, want %q", kind, c.Name, report.Normalized, c.Normalized)
+			}
+		}
+		if len(ValidInputs(kind)) == 0 || len(InvalidInputs(kind)) == 0 {
+			t.Errorf("%s: the corpus needs valid and invalid inputs", kind)
+		}
+	}
+
+	if Cases("iban") != nil {
+		t.Error("Cases(iban) != nil")
+	}
+}
+
+func TestCasesAreCopies(t *testing.T) {
+	for _, c := range Cases("carPlate") {
+		c.Input = "changed"
+		for key := range c.Options.Params {
+			c.Options.Params[key] = "changed"
+		}
+	}
+
+	for _, c := range Cases("carPlate") {
+		if c.Input == "changed" || c.Options.Params["type"] == "changed" {
+			t.Fatalf("modifying the result of Cases changed the corpus: %+v", c)
+		}
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	tests := []struct {
+		name     string
+		assert   func(tb testing.TB)
+		failures int
+	}{
+		{"valid", func(tb testing.TB) { AssertValid(tb, "cin", "12345678") }, 0},
+		{"valid fails", func(tb testing.TB) { AssertValid(tb, "cin", "22345678") }, 1},
+		{"valid with options", func(tb testing.TB) {
+			AssertValid(tb, "carPlate", "RS 123 تونس", validators.Options{Params: map[string]string{"type": "special"}})
+		}, 0},
+		{"invalid", func(tb testing.TB) { AssertInvalid(tb, "cin", "22345678", types.ErrBadPrefix) }, 0},
+		{"invalid any code", func(tb testing.TB) { AssertInvalid(tb, "rib", "1", "") }, 0},
+		{"invalid fails", func(tb testing.TB) { AssertInvalid(tb, "cin", "12345678", "") }, 1},
+		{"invalid wrong code", func(tb testing.TB) { AssertInvalid(tb, "cin", "1234567", types.ErrBadPrefix) }, 1},
+		{"error code", func(tb testing.TB) {
+			AssertErrorCode(tb, fmt.Errorf("wrapped: %w", validators.CheckRIB("1")), types.ErrTooShort)
+		}, 0},
+		{"error code not structured", func(tb testing.TB) { AssertErrorCode(tb, fmt.Errorf("plain"), types.ErrTooShort) }, 1},
+		{"corpus", func(tb testing.TB) {
+			// A check accepting only strict 8-digit numbers disagrees on the normalized inputs
+			AssertCorpus(tb, "cin", func(input string, _ validators.Options) error {
+				return validators.CheckCIN(input, types.ValidationOptions{Strict: true})
+			})
+		}, 4},
+		{"corpus other errors", func(tb testing.TB) {
+			AssertCorpus(tb, "postal", func(input string, opts validators.Options) error {
+				if validators.DefaultRegistry.Check("postal", input, opts) != nil {
+					return fmt.Errorf("bad postal code")
+				}
+				return nil
+			})
+		}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			tt.assert(r)
+			if len(r.failures) != tt.failures {
+				t.Errorf("got %d failures %q, want %d", len(r.failures), r.failures, tt.failures)
+			}
+		})
+	}
+}
+
+func TestQuickGenerators(t *testing.T) {
+	properties := []any{
+		func(v ValidCIN) bool { return types.CIN(v).Validate() == nil },
+		func(v ValidPhoneNumber) bool { return types.PhoneNumber(v).Validate() == nil },
+		func(v ValidTaxID) bool { return types.TaxID(v).Validate() == nil },
+		func(v ValidRIB) bool { return validators.ValidateRIBChecksum(string(v)) },
+		func(v ValidPostalCode) bool { return types.PostalCode(v).Governorate() != nil },
+		func(v ValidCarPlate) bool { return validators.ValidateCarPlate(string(v)) },
+		func(v NearMiss) bool { return fake.Check(v.Kind, v.Value, false) != nil && v.Code != "" },
+	}
+	for _, property := range properties {
+		if err := quick.Check(property, nil); err != nil {
+			t.Error(err)
+		}
+	}
+}
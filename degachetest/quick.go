@@ -0,0 +1,73 @@
+package degachetest
+
+import (
+	"math/rand"
+	"reflect"
+
+	"github.com/degache-go/degache/fake"
+)
+
+// Values generated by testing/quick. They implement quick.Generator with
+// package fake, and convert to the types of package types:
+//
+//	quick.Check(func(cin degachetest.ValidCIN) bool {
+//	    return types.CIN(cin).Validate() == nil
+//	}, nil)
+type (
+	// ValidCIN is a valid CIN
+	ValidCIN string
+	// ValidPhoneNumber is a valid mobile number in national format
+	ValidPhoneNumber string
+	// ValidTaxID is a valid Tax ID
+	ValidTaxID string
+	// ValidRIB is a valid RIB with a correct key
+	ValidRIB string
+	// ValidPostalCode is the postal code of a governorate
+	ValidPostalCode string
+	// ValidCarPlate is a valid standard car plate
+	ValidCarPlate string
+	// NearMiss is an invalid value of a random kind, see fake.NearMiss
+	NearMiss fake.NearMiss
+)
+
+// generator returns a fake.Generator seeded from r
+func generator(r *rand.Rand) *fake.Generator {
+	return fake.New(r.Int63())
+}
+
+// Generate implements quick.Generator
+func (ValidCIN) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidCIN(generator(r).CIN()))
+}
+
+// Generate implements quick.Generator
+func (ValidPhoneNumber) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidPhoneNumber(generator(r).PhoneNumber()))
+}
+
+// Generate implements quick.Generator
+func (ValidTaxID) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidTaxID(generator(r).TaxID()))
+}
+
+// Generate implements quick.Generator
+func (ValidRIB) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidRIB(generator(r).RIB()))
+}
+
+// Generate implements quick.Generator
+func (ValidPostalCode) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidPostalCode(generator(r).PostalCode()))
+}
+
+// Generate implements quick.Generator
+func (ValidCarPlate) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(ValidCarPlate(generator(r).CarPlate()))
+}
+
+// Generate implements quick.Generator
+func (NearMiss) Generate(r *rand.Rand, _ int) reflect.Value {
+	kinds := []string{"cin", "phone", "taxID", "rib", "postal", "carPlate"}
+	miss, _ := generator(r).NearMiss(kinds[r.Intn(len(kinds))])
+	return reflect.ValueOf(NearMiss(miss))
+}
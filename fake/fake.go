@@ -0,0 +1,196 @@
+// Package fake generates realistic Tunisian identifiers for tests, demos and
+// seed data: valid values that pass the degache validators, and near misses
+// that fail them with a known error code.
+//
+// Generators are seeded, so the same seed always produces the same values.
+//
+// Example usage:
+//
+//	g := fake.New(42)
+//	fmt.Println(g.CIN(), g.PhoneNumber(), g.RIB())
+//
+//	miss, _ := g.NearMiss("rib")
+//	fmt.Println(miss.Value, miss.Code) // e.g. 01234567890123456790 checksum
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+)
+
+// Errors returned for unknown names
+var (
+	ErrUnknownKind    = errors.New("unknown kind")
+	ErrUnknownCarrier = errors.New("unknown carrier")
+	ErrUnknownBank    = errors.New("unknown bank code")
+)
+
+// Generator produces pseudo-random identifiers from a seed.
+// A Generator is not safe for concurrent use.
+type Generator struct {
+	rng *rand.Rand
+}
+
+// New creates a generator
+//
+// Parameters:
+//   - seed: The seed; generators with the same seed produce the same values
+//
+// Returns:
+//   - *Generator: the generator
+func New(seed int64) *Generator {
+	return &Generator{rng: rand.New(rand.NewSource(seed))}
+}
+
+// digits returns n random digits
+func (g *Generator) digits(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + g.rng.Intn(10))
+	}
+	return string(b)
+}
+
+// pick returns a random element of list
+func (g *Generator) pick(list []string) string {
+	return list[g.rng.Intn(len(list))]
+}
+
+// sortedKeys returns the keys of m in ascending order, so that picks do not
+// depend on the map iteration order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CIN returns a valid CIN: 8 digits starting with 0 or 1
+func (g *Generator) CIN() string {
+	return g.pick([]string{"0", "1"}) + g.digits(7)
+}
+
+// PhoneNumber returns a valid mobile number of a random carrier, in national format (8 digits)
+func (g *Generator) PhoneNumber() string {
+	number, _ := g.PhoneNumberOf(g.pick(sortedKeys(constants.Carriers)))
+	return number
+}
+
+// PhoneNumberOf returns a valid mobile number of a carrier, in national format
+//
+// Parameters:
+//   - carrier: A key of constants.Carriers ("ORANGE") or a carrier name ("Orange Tunisia"), case-insensitive
+//
+// Returns:
+//   - string: the phone number
+//   - error: ErrUnknownCarrier if there is no such carrier
+//
+// Example:
+//
+//	number, _ := fake.New(1).PhoneNumberOf("TELECOM") // 9XXXXXXX
+func (g *Generator) PhoneNumberOf(carrier string) (string, error) {
+	for _, key := range sortedKeys(constants.Carriers) {
+		c := constants.Carriers[key]
+		if strings.EqualFold(key, carrier) || strings.EqualFold(c.Name, carrier) {
+			prefix := g.pick(c.Prefixes)
+			return prefix + g.digits(8-len(prefix)), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownCarrier, carrier)
+}
+
+// TaxID returns a valid Tax ID (matricule fiscal) such as "1234567A/P/M/000"
+func (g *Generator) TaxID() string {
+	const (
+		keys       = "ABCDEFGHJKLMNPQRSTVWXYZ" // I, O and U are not used
+		vatCodes   = "ABDNP"
+		categories = "CEMNP"
+	)
+	establishment := "000"
+	if g.rng.Intn(5) == 0 {
+		establishment = fmt.Sprintf("%03d", 1+g.rng.Intn(20))
+	}
+	return fmt.Sprintf("%s%c/%c/%c/%s", g.digits(7),
+		keys[g.rng.Intn(len(keys))], vatCodes[g.rng.Intn(len(vatCodes))],
+		categories[g.rng.Intn(len(categories))], establishment)
+}
+
+// RIB returns a valid RIB of a random bank, with a correct key
+func (g *Generator) RIB() string {
+	rib, _ := g.RIBOf(g.pick(sortedKeys(constants.Banks)))
+	return rib
+}
+
+// RIBOf returns a valid RIB of a bank, with a correct key
+//
+// Parameters:
+//   - bankCode: A 2-digit code of constants.Banks
+//
+// Returns:
+//   - string: the RIB
+//   - error: ErrUnknownBank if the bank code is not known
+func (g *Generator) RIBOf(bankCode string) (string, error) {
+	if _, ok := constants.Banks[bankCode]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownBank, bankCode)
+	}
+	return withKey(bankCode + g.digits(16)), nil
+}
+
+// withKey appends the key of validators.ValidateRIBChecksum to the first 18 digits of a RIB
+func withKey(rib string) string {
+	n, _ := strconv.ParseInt(rib[:18], 10, 64)
+	return fmt.Sprintf("%s%02d", rib[:18], n%97)
+}
+
+// PostalCode returns the postal code of a random governorate
+func (g *Generator) PostalCode() string {
+	return constants.Governorates[g.pick(sortedKeys(constants.Governorates))].PostalCode
+}
+
+// CarPlate returns a valid standard car plate such as "123 تونس 4567"
+func (g *Generator) CarPlate() string {
+	return fmt.Sprintf("%d تونس %04d", 100+g.rng.Intn(160), 1+g.rng.Intn(9999))
+}
+
+// SpecialCarPlate returns a valid special (RS) car plate such as "RS 123 تونس"
+func (g *Generator) SpecialCarPlate() string {
+	return fmt.Sprintf("RS %03d تونس", 1+g.rng.Intn(999))
+}
+
+// Value returns a valid value of a kind
+//
+// Parameters:
+//   - kind: A validator name: "cin", "phone", "taxID", "rib", "postal" or "carPlate" (case-insensitive);
+//     car plates are standard or special at random
+//
+// Returns:
+//   - string: the value
+//   - error: ErrUnknownKind if kind is not supported
+func (g *Generator) Value(kind string) (string, error) {
+	switch strings.ToLower(kind) {
+	case "cin":
+		return g.CIN(), nil
+	case "phone":
+		return g.PhoneNumber(), nil
+	case "taxid":
+		return g.TaxID(), nil
+	case "rib":
+		return g.RIB(), nil
+	case "postal":
+		return g.PostalCode(), nil
+	case "carplate":
+		if g.rng.Intn(4) == 0 {
+			return g.SpecialCarPlate(), nil
+		}
+		return g.CarPlate(), nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownKind, kind)
+}
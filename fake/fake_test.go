@@ -0,0 +1,119 @@
+package fake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+var kinds = []string{"cin", "phone", "taxID", "rib", "postal", "carPlate"}
+
+func TestValuesAreValid(t *testing.T) {
+	g := New(1)
+	for _, kind := range kinds {
+		for i := 0; i < 1000; i++ {
+			value, err := g.Value(kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := Check(kind, value, true); err != nil {
+				t.Fatalf("%s %q: %v", kind, value, err)
+			}
+		}
+	}
+}
+
+func TestSeedIsReproducible(t *testing.T) {
+	a, b := New(42), New(42)
+	for i := 0; i < 100; i++ {
+		for _, kind := range kinds {
+			va, _ := a.Value(kind)
+			vb, _ := b.Value(kind)
+			if va != vb {
+				t.Fatalf("%s: %q != %q with the same seed", kind, va, vb)
+			}
+		}
+	}
+
+	if New(1).RIB() == New(2).RIB() {
+		t.Error("different seeds produced the same RIB")
+	}
+}
+
+func TestOf(t *testing.T) {
+	g := New(3)
+	tests := []struct {
+		carrier string
+		want    string
+	}{
+		{"ORANGE", "Orange Tunisia"},
+		{"tunisie telecom", "Tunisie Telecom"},
+		{"OOREDOO", "Ooredoo Tunisia"},
+	}
+	for _, tt := range tests {
+		number, err := g.PhoneNumberOf(tt.carrier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info := validators.GetCarrierInfo(number); info == nil || info.Carrier.Name != tt.want {
+			t.Errorf("PhoneNumberOf(%q) = %q, carrier %+v", tt.carrier, number, info)
+		}
+	}
+
+	rib, err := g.RIBOf("20")
+	if err != nil || validators.GetBankFromRIB(rib).Bank.Name != "Amen Bank" {
+		t.Errorf("RIBOf(20) = %q, %v", rib, err)
+	}
+
+	if _, err := g.PhoneNumberOf("Tunisiana"); !errors.Is(err, ErrUnknownCarrier) {
+		t.Errorf("PhoneNumberOf(Tunisiana) error = %v", err)
+	}
+	if _, err := g.RIBOf("99"); !errors.Is(err, ErrUnknownBank) {
+		t.Errorf("RIBOf(99) error = %v", err)
+	}
+	if _, err := g.Value("iban"); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("Value(iban) error = %v", err)
+	}
+	if _, err := g.NearMiss("iban"); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("NearMiss(iban) error = %v", err)
+	}
+}
+
+func TestNearMiss(t *testing.T) {
+	g := New(5)
+	for _, kind := range kinds {
+		codes := make(map[types.ErrorCode]int)
+		for i := 0; i < 1000; i++ {
+			miss, err := g.NearMiss(kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if Check(kind, miss.Valid, true) != nil {
+				t.Fatalf("%s: source value %q is invalid", kind, miss.Valid)
+			}
+			if err := Check(kind, miss.Value, false); !errors.Is(err, miss.Code) || miss.Code == "" {
+				t.Fatalf("%s %q: error %v, want code %q", kind, miss.Value, err, miss.Code)
+			}
+			if Check(kind, miss.Value, true) == nil {
+				t.Fatalf("%s %q is valid in strict mode", kind, miss.Value)
+			}
+			codes[miss.Code]++
+		}
+		// CheckCarPlate reports every defect as bad_format
+		if len(codes) < 2 && kind != "carPlate" {
+			t.Errorf("%s: near misses only produce %v", kind, codes)
+		}
+	}
+
+	// Defects specific to RIBs
+	codes := make(map[types.ErrorCode]bool)
+	for i := 0; i < 200; i++ {
+		miss, _ := g.NearMiss("rib")
+		codes[miss.Code] = true
+	}
+	if !codes[types.ErrUnknownBank] || !codes[types.ErrChecksum] {
+		t.Errorf("rib near misses = %v, want unknown_bank and checksum", codes)
+	}
+}
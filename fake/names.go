@@ -0,0 +1,51 @@
+package fake
+
+// Name is a name in Arabic and in its usual French transliteration
+type Name struct {
+	Arabic string `json:"arabic"`
+	French string `json:"french"`
+}
+
+// String returns the French transliteration
+func (n Name) String() string {
+	return n.French
+}
+
+// maleFirstNames, femaleFirstNames and lastNames are common Tunisian names
+var (
+	maleFirstNames = []Name{
+		{"محمد", "Mohamed"}, {"أحمد", "Ahmed"}, {"علي", "Ali"}, {"يوسف", "Youssef"},
+		{"عمر", "Omar"}, {"حمزة", "Hamza"}, {"أيمن", "Aymen"}, {"سامي", "Sami"},
+		{"كريم", "Karim"}, {"هيثم", "Haythem"}, {"وليد", "Walid"}, {"منير", "Mounir"},
+		{"نبيل", "Nabil"}, {"رامي", "Rami"}, {"بلال", "Bilel"}, {"أنيس", "Anis"},
+		{"فارس", "Fares"}, {"ياسين", "Yassine"}, {"خليل", "Khalil"}, {"مهدي", "Mehdi"},
+	}
+	femaleFirstNames = []Name{
+		{"فاطمة", "Fatma"}, {"مريم", "Mariem"}, {"آمنة", "Amna"}, {"سلمى", "Salma"},
+		{"إيمان", "Imen"}, {"أسماء", "Asma"}, {"نور", "Nour"}, {"رحاب", "Rihab"},
+		{"سيرين", "Syrine"}, {"ياسمين", "Yasmine"}, {"هالة", "Hela"}, {"سناء", "Sana"},
+		{"منى", "Mouna"}, {"ليلى", "Leila"}, {"خديجة", "Khadija"}, {"إيناس", "Ines"},
+		{"وفاء", "Wafa"}, {"سارة", "Sarra"}, {"رانية", "Rania"}, {"نادية", "Nadia"},
+	}
+	lastNames = []Name{
+		{"الطرابلسي", "Trabelsi"}, {"الغربي", "Gharbi"}, {"الهمامي", "Hammami"}, {"الدريدي", "Dridi"},
+		{"الجلاصي", "Jlassi"}, {"الخليفي", "Khelifi"}, {"المزوغي", "Mzoughi"}, {"بوقرة", "Bouguerra"},
+		{"الشابي", "Chebbi"}, {"السويسي", "Souissi"}, {"الزواري", "Zouari"}, {"العياري", "Ayari"},
+		{"محجوب", "Mahjoub"}, {"الرويسي", "Rouissi"}, {"الدقاشي", "Degachi"}, {"بن سالم", "Ben Salem"},
+		{"بن عمر", "Ben Amor"}, {"الفرجاني", "Ferjani"}, {"الكعبي", "Kaabi"}, {"النفزي", "Nefzi"},
+		{"الحداد", "Haddad"}, {"مبروك", "Mabrouk"}, {"القاسمي", "Gasmi"}, {"بالحاج", "Belhaj"},
+	}
+)
+
+// streets are common street names, combined with "Rue", "Avenue" or "Boulevard"
+var streets = []string{
+	"Habib Bourguiba", "de la Liberté", "Ibn Khaldoun", "Farhat Hached", "de Carthage",
+	"Hédi Chaker", "Mongi Slim", "Tahar Haddad", "de la République", "Ali Belhouane",
+	"de Palestine", "Alain Savary", "du 9 Avril", "de Marseille", "Mohamed V",
+}
+
+// sectors are activities used in company names
+var sectors = []string{
+	"Informatique", "Bâtiment", "Textile", "Agroalimentaire", "Transport",
+	"Commerce", "Tourisme", "Conseil", "Industries Mécaniques", "Distribution",
+}
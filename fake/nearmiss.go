@@ -0,0 +1,166 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// NearMiss is an invalid value derived from a valid one by a single defect,
+// for negative tests
+type NearMiss struct {
+	// Kind is the validator name (e.g. "rib")
+	Kind string `json:"kind"`
+	// Value is the invalid value
+	Value string `json:"value"`
+	// Valid is the valid value it was derived from
+	Valid string `json:"valid"`
+	// Code is the error code reported by Check for Value
+	Code types.ErrorCode `json:"code"`
+}
+
+// Check validates a value of kind with the function that detects every
+// near miss: the Check function of the kind, CheckRIBChecksum for RIBs, and
+// CheckCarPlate with the type of the plate
+//
+// Parameters:
+//   - kind: The validator name
+//   - value: The value to validate
+//   - strict: Whether to validate in strict mode
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+func Check(kind, value string, strict bool) error {
+	opts := types.ValidationOptions{Strict: strict}
+	switch strings.ToLower(kind) {
+	case "cin":
+		return validators.CheckCIN(value, opts)
+	case "phone":
+		return validators.CheckPhoneNumber(value, types.PhoneNumberValidationOptions{Strict: strict})
+	case "taxid":
+		return validators.CheckTaxID(value, opts)
+	case "rib":
+		return validators.CheckRIBChecksum(value, opts)
+	case "postal":
+		return validators.CheckPostalCode(value, opts)
+	case "carplate":
+		plateOpts := types.CarPlateValidationOptions{Strict: strict}
+		if strings.HasPrefix(value, "RS") {
+			plateOpts.Type = "special"
+		}
+		return validators.CheckCarPlate(value, plateOpts)
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownKind, kind)
+}
+
+// NearMiss returns an invalid value of a kind: a valid value with one defect
+// such as a wrong prefix, a missing or extra digit, a letter among digits, an
+// unknown bank code or a wrong RIB key. The value is invalid in strict and
+// default mode.
+//
+// Parameters:
+//   - kind: A validator name, as for Value
+//
+// Returns:
+//   - NearMiss: the invalid value, the valid value it comes from and the error code reported by Check
+//   - error: ErrUnknownKind if kind is not supported
+//
+// Example:
+//
+//	miss, _ := fake.New(7).NearMiss("cin")
+//	err := validators.CheckCIN(miss.Value) // errors.Is(err, miss.Code)
+func (g *Generator) NearMiss(kind string) (NearMiss, error) {
+	valid, err := g.Value(kind)
+	if err != nil {
+		return NearMiss{}, err
+	}
+
+	value := g.defect(strings.ToLower(kind), valid)
+	miss := NearMiss{Kind: kind, Value: value, Valid: valid}
+	if verr := validators.AsValidationError(Check(kind, value, false)); verr != nil {
+		miss.Code = verr.Code
+	}
+	return miss, nil
+}
+
+// defect returns valid with one random defect
+func (g *Generator) defect(kind, valid string) string {
+	runes := []rune(valid)
+	last := len(runes) - 1
+
+	// Defects common to every kind
+	common := []func() string{
+		func() string { return string(runes[:last]) }, // missing character
+		func() string { return valid[:1] + valid },    // extra character
+		func() string { // letter instead of a digit
+			i := g.digitIndex(runes)
+			return string(runes[:i]) + string(rune('A'+g.rng.Intn(26))) + string(runes[i+1:])
+		},
+	}
+
+	var specific []func() string
+	switch kind {
+	case "cin":
+		specific = append(specific, func() string { return string(rune('2'+g.rng.Intn(8))) + valid[1:] })
+	case "phone":
+		specific = append(specific, func() string { return g.pick(invalidPhonePrefixes()) + valid[1:] })
+	case "taxid":
+		specific = append(specific,
+			func() string { return valid[:8] + "-" + valid[9:] },                              // wrong separator
+			func() string { return valid[:7] + string(rune('0'+g.rng.Intn(10))) + valid[8:] }, // digit instead of the key letter
+		)
+	case "rib":
+		specific = append(specific,
+			func() string { return withKey(g.pick(unknownBankCodes()) + valid[2:]) },
+			func() string { // wrong key
+				key := (int(valid[18]-'0')*10 + int(valid[19]-'0') + 1 + g.rng.Intn(96)) % 97
+				return fmt.Sprintf("%s%02d", valid[:18], key)
+			},
+		)
+	case "carplate":
+		specific = append(specific, func() string { return strings.Replace(valid, "تونس", "Tunis", 1) })
+	}
+
+	defects := append(common, specific...)
+	return defects[g.rng.Intn(len(defects))]()
+}
+
+// digitIndex returns the index of a random digit of runes
+func (g *Generator) digitIndex(runes []rune) int {
+	var indexes []int
+	for i, r := range runes {
+		if r >= '0' && r <= '9' {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes[g.rng.Intn(len(indexes))]
+}
+
+// invalidPhonePrefixes returns the digits that no carrier uses as a prefix
+func invalidPhonePrefixes() []string {
+	var prefixes []string
+	for d := '0'; d <= '9'; d++ {
+		valid := false
+		for _, prefix := range constants.ValidPrefixes {
+			valid = valid || prefix == string(d)
+		}
+		if !valid {
+			prefixes = append(prefixes, string(d))
+		}
+	}
+	return prefixes
+}
+
+// unknownBankCodes returns the 2-digit codes of no bank
+func unknownBankCodes() []string {
+	var codes []string
+	for n := 0; n < 100; n++ {
+		if code := fmt.Sprintf("%02d", n); constants.Banks[code].Code == "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
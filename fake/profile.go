@@ -0,0 +1,121 @@
+package fake
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/types"
+)
+
+// Birth dates are drawn between these dates, independently of the current
+// time so that a seed always produces the same profiles
+var (
+	minBirthDate = time.Date(1945, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxBirthDate = time.Date(2006, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// Person is a fake Tunisian person
+type Person struct {
+	FirstName Name              `json:"firstName"`
+	LastName  Name              `json:"lastName"`
+	Gender    string            `json:"gender"` // "male" or "female"
+	BirthDate time.Time         `json:"birthDate"`
+	CIN       types.CIN         `json:"cin"`
+	Phone     types.PhoneNumber `json:"phone"`
+	Address   Address           `json:"address"`
+}
+
+// FullName returns the first and last name in French transliteration
+func (p Person) FullName() string {
+	return p.FirstName.French + " " + p.LastName.French
+}
+
+// Company is a fake Tunisian company
+type Company struct {
+	Name      string            `json:"name"`
+	LegalForm string            `json:"legalForm"` // "SA", "SARL" or "SUARL"
+	TaxID     types.TaxID       `json:"taxID"`
+	RIB       types.RIB         `json:"rib"`
+	Phone     types.PhoneNumber `json:"phone"`
+	Address   Address           `json:"address"`
+}
+
+// Person returns a coherent fake person: the first name matches the gender,
+// the person is an adult born between 1945 and 2006, and the postal code of
+// the address is the one of its governorate
+//
+// Returns:
+//   - Person: the person, whose CIN, phone number and postal code pass the validators
+//
+// Example:
+//
+//	p := fake.New(1).Person()
+//	fmt.Println(p.FullName(), p.LastName.Arabic, p.Address.Governorate)
+func (g *Generator) Person() Person {
+	p := Person{Gender: "male", FirstName: maleFirstNames[g.rng.Intn(len(maleFirstNames))]}
+	if g.rng.Intn(2) == 0 {
+		p.Gender, p.FirstName = "female", femaleFirstNames[g.rng.Intn(len(femaleFirstNames))]
+	}
+	p.LastName = lastNames[g.rng.Intn(len(lastNames))]
+
+	days := int(maxBirthDate.Sub(minBirthDate).Hours() / 24)
+	p.BirthDate = minBirthDate.AddDate(0, 0, g.rng.Intn(days+1))
+
+	p.CIN = types.CIN(g.CIN())
+	p.Phone = types.PhoneNumber(g.PhoneNumber())
+	p.Address = g.Address()
+	return p
+}
+
+// Company returns a coherent fake company: its Tax ID has the category of
+// legal entities (M) and its RIB a known bank code and a correct key
+//
+// Returns:
+//   - Company: the company
+//
+// Example:
+//
+//	c := fake.New(1).Company()
+//	fmt.Println(c.Name, c.TaxID, c.RIB.Bank().Bank.Name)
+func (g *Generator) Company() Company {
+	c := Company{LegalForm: g.pick([]string{"SA", "SARL", "SUARL"})}
+
+	sector := g.pick(sectors)
+	if g.rng.Intn(2) == 0 {
+		c.Name = fmt.Sprintf("%s %s %s", lastNames[g.rng.Intn(len(lastNames))].French, sector, c.LegalForm)
+	} else {
+		c.Name = fmt.Sprintf("Société Tunisienne de %s %s", sector, c.LegalForm)
+	}
+
+	taxID := []byte(g.TaxID())
+	taxID[11] = 'M' // category: legal entity
+	c.TaxID = types.TaxID(taxID)
+
+	c.RIB = types.RIB(g.RIB())
+	c.Phone = types.PhoneNumber(g.PhoneNumber())
+	c.Address = g.Address()
+	return c
+}
+
+// Address is a fake address. It has the fields of types.Address with camelCase
+// JSON names and converts to it with types.Address(a).
+type Address struct {
+	Street      string           `json:"street"`
+	City        string           `json:"city"`
+	PostalCode  types.PostalCode `json:"postalCode"`
+	Governorate string           `json:"governorate"`
+}
+
+// Address returns an address in the main city of a random governorate, with its postal code
+func (g *Generator) Address() Address {
+	governorate := constants.Governorates[g.pick(sortedKeys(constants.Governorates))]
+	street := fmt.Sprintf("%d %s %s", 1+g.rng.Intn(150), g.pick([]string{"Rue", "Avenue", "Boulevard"}), g.pick(streets))
+
+	return Address{
+		Street:      street,
+		City:        governorate.Name,
+		PostalCode:  types.PostalCode(governorate.PostalCode),
+		Governorate: governorate.Name,
+	}
+}
@@ -0,0 +1,88 @@
+package fake
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+func TestPerson(t *testing.T) {
+	g := New(8)
+	genders := make(map[string]int)
+	for i := 0; i < 500; i++ {
+		p := g.Person()
+		genders[p.Gender]++
+
+		if p.CIN.Validate() != nil || p.Phone.Validate() != nil || p.Address.PostalCode.Validate() != nil {
+			t.Fatalf("invalid person %+v", p)
+		}
+		names := maleFirstNames
+		if p.Gender == "female" {
+			names = femaleFirstNames
+		}
+		found := false
+		for _, name := range names {
+			found = found || name == p.FirstName
+		}
+		if !found {
+			t.Errorf("first name %v does not match gender %s", p.FirstName, p.Gender)
+		}
+		if p.BirthDate.Before(minBirthDate) || p.BirthDate.After(maxBirthDate) {
+			t.Errorf("birth date %v out of range", p.BirthDate)
+		}
+		if governorate := p.Address.PostalCode.Governorate(); governorate == nil || governorate.Name != p.Address.Governorate {
+			t.Errorf("postal code %s does not match governorate %s", p.Address.PostalCode, p.Address.Governorate)
+		}
+	}
+	if genders["male"] == 0 || genders["female"] == 0 {
+		t.Errorf("genders = %v", genders)
+	}
+}
+
+func TestCompany(t *testing.T) {
+	g := New(9)
+	for i := 0; i < 500; i++ {
+		c := g.Company()
+		if c.TaxID.Validate() != nil || c.TaxID.Components().Type3 != "M" {
+			t.Fatalf("Tax ID %q is invalid or not a legal entity", c.TaxID)
+		}
+		if !validators.ValidateRIBChecksum(string(c.RIB)) || c.RIB.Bank() == nil {
+			t.Fatalf("RIB %q is invalid", c.RIB)
+		}
+		if c.Name == "" || c.Phone.Validate() != nil || c.Address.PostalCode.Governorate() == nil {
+			t.Fatalf("invalid company %+v", c)
+		}
+	}
+}
+
+func TestProfilesAreReproducible(t *testing.T) {
+	a, b := New(2024), New(2024)
+	for i := 0; i < 50; i++ {
+		if pa, pb := a.Person(), b.Person(); !reflect.DeepEqual(pa, pb) {
+			t.Fatalf("persons differ with the same seed: %+v, %+v", pa, pb)
+		}
+		if ca, cb := a.Company(), b.Company(); !reflect.DeepEqual(ca, cb) {
+			t.Fatalf("companies differ with the same seed: %+v, %+v", ca, cb)
+		}
+	}
+
+	data, err := json.Marshal(New(1).Person())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p Person
+	if err := json.Unmarshal(data, &p); err != nil {
+		t.Fatalf("a person does not round-trip through JSON: %v (%s)", err, data)
+	}
+	if !strings.Contains(string(data), `"postalCode":"`+string(p.Address.PostalCode)+`"`) {
+		t.Errorf("address fields should have camelCase JSON names: %s", data)
+	}
+
+	if address := types.Address(p.Address); address.Governorate != p.Address.Governorate {
+		t.Errorf("types.Address(%+v) = %+v", p.Address, address)
+	}
+}
@@ -0,0 +1,81 @@
+package formatters
+
+import "github.com/degache-go/degache/types"
+
+// MaskCIN hides the middle digits of a CIN for logs and receipts. The input is
+// normalized first; an invalid CIN is hidden entirely.
+//
+// Parameters:
+//   - cin: The CIN to mask
+//   - policy: The mask policy (optional, types.CINMaskPolicy by default)
+//
+// Returns:
+//   - string: the masked CIN
+//
+// Example:
+//
+//	MaskCIN("12345678") // "12****78"
+//	MaskCIN("12345678", types.MaskPolicy{Suffix: 3, Char: 'x'}) // "xxxxx678"
+func MaskCIN(cin string, policy ...types.MaskPolicy) string {
+	if parsed, err := types.ParseCIN(cin); err == nil {
+		return parsed.Mask(policy...)
+	}
+	return types.CIN(cin).Mask(policy...)
+}
+
+// MaskPhoneNumber hides the middle digits of a phone number and writes it in
+// international format. An invalid number is hidden entirely.
+//
+// Parameters:
+//   - phoneNumber: The phone number to mask, in any format accepted by ValidatePhoneNumber
+//   - policy: The mask policy, applied to the 8 national digits (optional, types.PhoneNumberMaskPolicy by default)
+//
+// Returns:
+//   - string: the masked phone number
+//
+// Example:
+//
+//	MaskPhoneNumber("20123456") // "+216 20 *** 456"
+func MaskPhoneNumber(phoneNumber string, policy ...types.MaskPolicy) string {
+	return types.PhoneNumber(phoneNumber).Mask(policy...)
+}
+
+// MaskRIB hides the digits of a RIB except the bank code and the key. The
+// input is normalized first; an invalid RIB is hidden entirely.
+//
+// Parameters:
+//   - rib: The RIB to mask
+//   - policy: The mask policy (optional, types.RIBMaskPolicy by default)
+//
+// Returns:
+//   - string: the masked RIB
+//
+// Example:
+//
+//	MaskRIB("01234567890123456789") // "01****************89"
+func MaskRIB(rib string, policy ...types.MaskPolicy) string {
+	if parsed, err := types.ParseRIB(rib); err == nil {
+		return parsed.Mask(policy...)
+	}
+	return types.RIB(rib).Mask(policy...)
+}
+
+// MaskTaxID hides the leading digits of the number of a Tax ID. The input is
+// normalized first; an invalid Tax ID is hidden entirely.
+//
+// Parameters:
+//   - taxID: The Tax ID to mask
+//   - policy: The mask policy, applied to the 7-digit number (optional, types.TaxIDMaskPolicy by default)
+//
+// Returns:
+//   - string: the masked Tax ID
+//
+// Example:
+//
+//	MaskTaxID("1234567A/P/M/000") // "****567A/P/M/000"
+func MaskTaxID(taxID string, policy ...types.MaskPolicy) string {
+	if parsed, err := types.ParseTaxID(taxID); err == nil {
+		return parsed.Mask(policy...)
+	}
+	return types.TaxID(taxID).Mask(policy...)
+}
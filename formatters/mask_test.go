@@ -0,0 +1,41 @@
+package formatters
+
+import (
+	"testing"
+
+	"github.com/degache-go/degache/types"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name string
+		mask func(string, ...types.MaskPolicy) string
+		in   string
+		want string
+	}{
+		{"CIN", MaskCIN, "12345678", "12****78"},
+		{"CIN Arabic-Indic digits", MaskCIN, "\u0661\u0662\u0663\u0664\u0665\u0666\u0667\u0668", "12****78"},
+		{"CIN invalid", MaskCIN, "2234567", "*******"},
+		{"Phone", MaskPhoneNumber, "20123456", "+216 20 *** 456"},
+		{"Phone with prefix and spaces", MaskPhoneNumber, "+216 20 123 456", "+216 20 *** 456"},
+		{"Phone invalid", MaskPhoneNumber, "10123456", "********"},
+		{"RIB", MaskRIB, "01234567890123456789", "01****************89"},
+		{"RIB grouped", MaskRIB, "01 234 5678901234567 89", "01****************89"},
+		{"RIB invalid", MaskRIB, "99234567890123456789", "********************"},
+		{"Tax ID", MaskTaxID, "1234567A/P/M/000", "****567A/P/M/000"},
+		{"Tax ID lower case", MaskTaxID, "1234567a/p/m/000", "****567A/P/M/000"},
+		{"Tax ID invalid", MaskTaxID, "1234567A-P-M-000", "****************"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mask(tt.in); got != tt.want {
+				t.Errorf("mask(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	if got := MaskCIN("12345678", types.MaskPolicy{Suffix: 3, Char: 'x'}); got != "xxxxx678" {
+		t.Errorf("MaskCIN with policy = %q, want %q", got, "xxxxx678")
+	}
+}
@@ -2,11 +2,9 @@ package formatters
 
 import (
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/degache-go/degache/constants"
-	"github.com/degache-go/degache/validators"
+	"github.com/degache-go/degache/types"
 )
 
 // FormatPhoneNumber formats a Tunisian phone number with country code and proper spacing
@@ -26,27 +24,13 @@ import (
 //	formatted, err := FormatPhoneNumber("+21620123456")
 //	// Returns: "+216 20 123 456", nil
 func FormatPhoneNumber(phoneNumber string) (string, error) {
-	if !validators.ValidatePhoneNumber(phoneNumber) {
-		return "", fmt.Errorf("invalid phone number: %s", phoneNumber)
-	}
-
-	// Remove all non-digit characters except +
-	cleaned := regexp.MustCompile(`[^\d+]`).ReplaceAllString(phoneNumber, "")
-
-	// Remove international prefix if present
-	cleaned = strings.TrimPrefix(cleaned, "+216")
-
-	// Ensure we have exactly 8 digits
-	if len(cleaned) != 8 {
-		return "", fmt.Errorf("phone number must be exactly 8 digits after cleaning")
+	national, err := nationalNumber(phoneNumber)
+	if err != nil {
+		return "", err
 	}
 
 	// Format as: +216 XX XXX XXX
-	return fmt.Sprintf("%s %s %s %s",
-		constants.CountryCode,
-		cleaned[:2],
-		cleaned[2:5],
-		cleaned[5:]), nil
+	return constants.CountryCode + " " + national[:2] + " " + national[2:5] + " " + national[5:], nil
 }
 
 // FormatPhoneNumberNational formats a phone number in national format (without country code)
@@ -63,26 +47,13 @@ func FormatPhoneNumber(phoneNumber string) (string, error) {
 //	formatted, err := FormatPhoneNumberNational("20123456")
 //	// Returns: "20 123 456", nil
 func FormatPhoneNumberNational(phoneNumber string) (string, error) {
-	if !validators.ValidatePhoneNumber(phoneNumber) {
-		return "", fmt.Errorf("invalid phone number: %s", phoneNumber)
-	}
-
-	// Remove all non-digit characters except +
-	cleaned := regexp.MustCompile(`[^\d+]`).ReplaceAllString(phoneNumber, "")
-
-	// Remove international prefix if present
-	cleaned = strings.TrimPrefix(cleaned, "+216")
-
-	// Ensure we have exactly 8 digits
-	if len(cleaned) != 8 {
-		return "", fmt.Errorf("phone number must be exactly 8 digits after cleaning")
+	national, err := nationalNumber(phoneNumber)
+	if err != nil {
+		return "", err
 	}
 
 	// Format as: XX XXX XXX
-	return fmt.Sprintf("%s %s %s",
-		cleaned[:2],
-		cleaned[2:5],
-		cleaned[5:]), nil
+	return national[:2] + " " + national[2:5] + " " + national[5:], nil
 }
 
 // FormatPhoneNumberCompact formats a phone number in compact format (no spaces)
@@ -99,23 +70,13 @@ func FormatPhoneNumberNational(phoneNumber string) (string, error) {
 //	formatted, err := FormatPhoneNumberCompact("20 123 456")
 //	// Returns: "+21620123456", nil
 func FormatPhoneNumberCompact(phoneNumber string) (string, error) {
-	if !validators.ValidatePhoneNumber(phoneNumber) {
-		return "", fmt.Errorf("invalid phone number: %s", phoneNumber)
-	}
-
-	// Remove all non-digit characters except +
-	cleaned := regexp.MustCompile(`[^\d+]`).ReplaceAllString(phoneNumber, "")
-
-	// Remove international prefix if present
-	cleaned = strings.TrimPrefix(cleaned, "+216")
-
-	// Ensure we have exactly 8 digits
-	if len(cleaned) != 8 {
-		return "", fmt.Errorf("phone number must be exactly 8 digits after cleaning")
+	national, err := nationalNumber(phoneNumber)
+	if err != nil {
+		return "", err
 	}
 
 	// Format as: +216XXXXXXXX
-	return constants.CountryCode + cleaned, nil
+	return constants.CountryCode + national, nil
 }
 
 // NormalizePhoneNumber normalizes a phone number by removing all formatting
@@ -132,20 +93,19 @@ func FormatPhoneNumberCompact(phoneNumber string) (string, error) {
 //	normalized, err := NormalizePhoneNumber("+216 20 123 456")
 //	// Returns: "20123456", nil
 func NormalizePhoneNumber(phoneNumber string) (string, error) {
-	if !validators.ValidatePhoneNumber(phoneNumber) {
-		return "", fmt.Errorf("invalid phone number: %s", phoneNumber)
+	national, err := nationalNumber(phoneNumber)
+	if err != nil {
+		return "", err
 	}
 
-	// Remove all non-digit characters except +
-	cleaned := regexp.MustCompile(`[^\d+]`).ReplaceAllString(phoneNumber, "")
-
-	// Remove international prefix if present
-	cleaned = strings.TrimPrefix(cleaned, "+216")
+	return national, nil
+}
 
-	// Ensure we have exactly 8 digits
-	if len(cleaned) != 8 {
-		return "", fmt.Errorf("phone number must be exactly 8 digits after cleaning")
+// nationalNumber validates a phone number and returns its 8 national digits
+func nationalNumber(phoneNumber string) (string, error) {
+	phone, err := types.ParsePhoneNumber(phoneNumber)
+	if err != nil {
+		return "", fmt.Errorf("invalid phone number: %s: %w", phoneNumber, err)
 	}
-
-	return cleaned, nil
+	return string(phone), nil
 }
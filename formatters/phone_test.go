@@ -1,6 +1,11 @@
 package formatters
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+)
 
 func TestFormatPhoneNumber(t *testing.T) {
 	tests := []struct {
@@ -130,3 +135,10 @@ func TestNormalizePhoneNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatPhoneNumberWrapsValidationError(t *testing.T) {
+	_, err := FormatPhoneNumber("10123456")
+	if !errors.Is(err, types.ErrBadPrefix) {
+		t.Errorf("FormatPhoneNumber() error = %v, want it to wrap %q", err, types.ErrBadPrefix)
+	}
+}
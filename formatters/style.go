@@ -0,0 +1,128 @@
+package formatters
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/degache-go/degache/types"
+)
+
+// ErrUnknownStyle is returned by Format for an unknown kind or style
+var ErrUnknownStyle = errors.New("unknown format style")
+
+// Styles lists the formatting styles of each kind of value accepted by Format.
+// The first style of each kind is its default.
+var Styles = map[string][]string{
+	"phone":    {"international", "national", "compact", "normalized"},
+	"currency": {"plain", "symbol", "code", "compact"},
+	"date":     {"long", "short", "numeric", "datetime"},
+}
+
+// DateLayouts lists the date layouts accepted by Format
+var DateLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02", "02/01/2006"}
+
+// Format formats a textual value by kind and style name.
+// It lets command-line tools and services select a formatter at run time.
+//
+// Parameters:
+//   - kind: "phone", "currency" (an amount in dinars) or "date" (see DateLayouts)
+//   - style: One of Styles[kind], or an empty string for the default style
+//   - value: The value to format
+//
+// Returns:
+//   - string: the formatted value
+//   - error: an error wrapping ErrUnknownStyle, or the error of the formatter
+//
+// Example:
+//
+//	formatted, err := Format("phone", "national", "+21620123456")
+//	// Returns: "20 123 456", nil
+func Format(kind, style, value string) (string, error) {
+	styles, ok := Styles[kind]
+	if !ok {
+		return "", fmt.Errorf("%w: kind %q", ErrUnknownStyle, kind)
+	}
+	if style == "" {
+		style = styles[0]
+	}
+
+	valid := false
+	for _, s := range styles {
+		valid = valid || s == style
+	}
+	if !valid {
+		return "", fmt.Errorf("%w: %s style %q (want %s)", ErrUnknownStyle, kind, style, strings.Join(styles, ", "))
+	}
+
+	switch kind {
+	case "phone":
+		return formatPhoneStyle(style, value)
+	case "currency":
+		return formatCurrencyStyle(style, value)
+	default:
+		return formatDateStyle(style, value)
+	}
+}
+
+// formatPhoneStyle formats a phone number
+func formatPhoneStyle(style, value string) (string, error) {
+	switch style {
+	case "national":
+		return FormatPhoneNumberNational(value)
+	case "compact":
+		return FormatPhoneNumberCompact(value)
+	case "normalized":
+		return NormalizePhoneNumber(value)
+	default:
+		return FormatPhoneNumber(value)
+	}
+}
+
+// formatCurrencyStyle formats an amount given as a plain number or in Tunisian format
+func formatCurrencyStyle(style, value string) (string, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		if amount, err = ParseCurrency(value); err != nil {
+			return "", err
+		}
+	}
+
+	switch style {
+	case "symbol":
+		return FormatCurrency(amount, types.CurrencyFormatOptions{Symbol: true}), nil
+	case "code":
+		return FormatCurrency(amount, types.CurrencyFormatOptions{Code: true}), nil
+	case "compact":
+		return FormatCurrencyCompact(amount), nil
+	default:
+		return FormatCurrency(amount), nil
+	}
+}
+
+// formatDateStyle formats a date given in one of DateLayouts
+func formatDateStyle(style, value string) (string, error) {
+	var date time.Time
+	var err error
+	for _, layout := range DateLayouts {
+		if date, err = time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q: want YYYY-MM-DD, DD/MM/YYYY or RFC 3339", value)
+	}
+
+	switch style {
+	case "short":
+		return FormatDateShort(date), nil
+	case "numeric":
+		return FormatDateNumeric(date), nil
+	case "datetime":
+		return FormatDateTime(date), nil
+	default:
+		return FormatDate(date), nil
+	}
+}
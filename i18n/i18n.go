@@ -0,0 +1,167 @@
+// Package i18n provides localized messages for degache validation failures
+// in Arabic (ar-TN), French (fr-TN) and English (en).
+//
+// Example usage:
+//
+//	err := validators.CheckCIN("2234567")
+//	fmt.Println(i18n.Message(err, i18n.French))
+//	// Le numéro de CIN doit comporter exactement 8 chiffres
+package i18n
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/degache-go/degache/types"
+)
+
+// Locale identifies a message language
+type Locale string
+
+// Supported locales
+const (
+	// English is the default locale
+	English Locale = "en"
+	// French is the Tunisian French locale
+	French Locale = "fr-TN"
+	// Arabic is the Tunisian Arabic locale
+	Arabic Locale = "ar-TN"
+)
+
+// ParseLocale maps a language tag or an Accept-Language header to a supported locale
+//
+// Parameters:
+//   - tag: A language tag ("ar", "fr-FR", "ar_TN") or a comma-separated list of them
+//
+// Returns:
+//   - Locale: the first supported locale in tag, English if none is supported
+//
+// Example:
+//
+//	locale := ParseLocale("fr-FR,fr;q=0.9,en;q=0.8") // French
+func ParseLocale(tag string) Locale {
+	for _, part := range strings.Split(tag, ",") {
+		language, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language = strings.ToLower(strings.ReplaceAll(language, "_", "-"))
+		language, _, _ = strings.Cut(language, "-")
+
+		switch language {
+		case "ar":
+			return Arabic
+		case "fr":
+			return French
+		case "en":
+			return English
+		}
+	}
+
+	return English
+}
+
+// Catalog holds message templates per locale.
+//
+// Templates are looked up by "field.code" (e.g. "cin.too_short"), then by
+// "code" alone, and finally in the English catalog. They may contain the
+// placeholders {field}, {expected} and {position} (1-based).
+// A Catalog is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[Locale]map[string]string
+}
+
+// NewCatalog creates a catalog containing the built-in messages
+func NewCatalog() *Catalog {
+	c := &Catalog{messages: make(map[Locale]map[string]string)}
+	for locale, messages := range builtinMessages {
+		for key, template := range messages {
+			c.Set(locale, key, template)
+		}
+	}
+	return c
+}
+
+// Set adds or replaces a message template
+//
+// Parameters:
+//   - locale: The locale of the template
+//   - key: "field.code" (e.g. "employee.bad_prefix"), "code", or "field.<name>" for a field label
+//   - template: The message template
+func (c *Catalog) Set(locale Locale, key, template string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.messages[locale] == nil {
+		c.messages[locale] = make(map[string]string)
+	}
+	c.messages[locale][key] = template
+}
+
+// Message returns the localized message of a validation error
+//
+// Parameters:
+//   - err: The error, usually returned by a Check function
+//   - locale: The message locale
+//
+// Returns:
+//   - string: the localized message, err.Error() if err is not a *types.ValidationError,
+//     or an empty string if err is nil
+func (c *Catalog) Message(err error, locale Locale) string {
+	if err == nil {
+		return ""
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) {
+		return err.Error()
+	}
+
+	template, ok := c.lookup(locale, verr.Field+"."+string(verr.Code), string(verr.Code))
+	if !ok {
+		return verr.Error()
+	}
+
+	field, ok := c.lookup(locale, "field."+verr.Field)
+	if !ok {
+		field = verr.Field
+	}
+
+	position := ""
+	if verr.Position >= 0 {
+		position = strconv.Itoa(verr.Position + 1)
+	}
+
+	return strings.NewReplacer(
+		"{field}", field,
+		"{expected}", verr.Expected,
+		"{position}", position,
+	).Replace(template)
+}
+
+// lookup returns the first template found for keys in locale, then in English
+func (c *Catalog) lookup(locale Locale, keys ...string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, l := range []Locale{locale, English} {
+		for _, key := range keys {
+			if template, ok := c.messages[l][key]; ok {
+				return template, true
+			}
+		}
+	}
+	return "", false
+}
+
+// DefaultCatalog contains the built-in messages
+var DefaultCatalog = NewCatalog()
+
+// Message returns the localized message of a validation error using DefaultCatalog
+//
+// Example:
+//
+//	msg := Message(validators.CheckPhoneNumber("10123456"), Arabic)
+func Message(err error, locale Locale) string {
+	return DefaultCatalog.Message(err, locale)
+}
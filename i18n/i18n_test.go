@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := map[string]Locale{
+		"ar-TN":                   Arabic,
+		"ar":                      Arabic,
+		"fr_FR":                   French,
+		"de-DE,fr;q=0.9,en;q=0.8": French,
+		"en-US":                   English,
+		"":                        English,
+		"de":                      English,
+	}
+
+	for tag, expected := range tests {
+		if locale := ParseLocale(tag); locale != expected {
+			t.Errorf("ParseLocale(%q) = %q, want %q", tag, locale, expected)
+		}
+	}
+}
+
+func TestMessageCoversEveryFailure(t *testing.T) {
+	failures := []error{
+		validators.CheckCIN(""),
+		validators.CheckCIN("1234567"),
+		validators.CheckCIN("123456789"),
+		validators.CheckCIN("22345678"),
+		validators.CheckCIN("1234567a"),
+		validators.CheckPhoneNumber(""),
+		validators.CheckPhoneNumber("2012345"),
+		validators.CheckPhoneNumber("201234567"),
+		validators.CheckPhoneNumber("10123456"),
+		validators.CheckPhoneNumber("30123456"),
+		validators.CheckPhoneNumber("20 123 456", types.PhoneNumberValidationOptions{Strict: true}),
+		validators.CheckTaxID(""),
+		validators.CheckTaxID("123"),
+		validators.CheckTaxID("1234567A/P/M/0000"),
+		validators.CheckTaxID("1234567a/P/M/000"),
+		validators.CheckTaxID("1234567A-P/M/000"),
+		validators.CheckRIB(""),
+		validators.CheckRIB("0123"),
+		validators.CheckRIB("012345678901234567890"),
+		validators.CheckRIB("0123456789012345678x"),
+		validators.CheckRIB("99234567890123456789"),
+		validators.CheckRIBChecksum("01234567890123456789"),
+		validators.CheckPostalCode(""),
+		validators.CheckPostalCode("100"),
+		validators.CheckPostalCode("10000"),
+		validators.CheckPostalCode("10a0"),
+		validators.CheckCarPlate(""),
+		validators.CheckCarPlate("123 4567"),
+		validators.CheckCarPlate("12 تونس 4567"),
+		validators.CheckCarPlate("123 تونس 4567", types.CarPlateValidationOptions{Type: "diplomatic"}),
+	}
+
+	for _, err := range failures {
+		english := Message(err, English)
+		if english == "" || strings.Contains(english, "{") {
+			t.Errorf("English message for %v = %q", err, english)
+		}
+
+		for _, locale := range []Locale{French, Arabic} {
+			msg := Message(err, locale)
+			if msg == "" || msg == english || strings.Contains(msg, "{") {
+				t.Errorf("%s message for %v = %q", locale, err, msg)
+			}
+		}
+	}
+}
+
+func TestMessageInterpolation(t *testing.T) {
+	err := validators.CheckCIN("1234567a")
+	expected := "Le numéro de CIN ne doit contenir que des chiffres (caractère invalide en position 8)"
+	if msg := Message(err, French); msg != expected {
+		t.Errorf("Message() = %q, want %q", msg, expected)
+	}
+
+	if msg := Message(validators.CheckPhoneNumber("10123456"), Arabic); !strings.Contains(msg, "2-9") {
+		t.Errorf("Message() = %q, want the expected prefixes", msg)
+	}
+}
+
+func TestCatalogCustomMessages(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Set(French, "field.employee", "Le matricule employé")
+	catalog.Set(French, "employee.bad_prefix", "{field} doit commencer par {expected}")
+
+	err := &types.ValidationError{Code: types.ErrBadPrefix, Field: "employee", Position: 0, Expected: "EMP-"}
+	if msg := catalog.Message(err, French); msg != "Le matricule employé doit commencer par EMP-" {
+		t.Errorf("Message() = %q", msg)
+	}
+
+	if msg := catalog.Message(errors.New("plain error"), Arabic); msg != "plain error" {
+		t.Errorf("Message(plain error) = %q, want the error text", msg)
+	}
+	if msg := catalog.Message(nil, Arabic); msg != "" {
+		t.Errorf("Message(nil) = %q, want empty", msg)
+	}
+}
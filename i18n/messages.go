@@ -0,0 +1,148 @@
+package i18n
+
+// builtinMessages contains the built-in message templates of every locale
+var builtinMessages = map[Locale]map[string]string{
+	English: {
+		"field.cin":      "CIN",
+		"field.phone":    "Phone number",
+		"field.taxID":    "Tax ID",
+		"field.rib":      "RIB",
+		"field.postal":   "Postal code",
+		"field.carPlate": "Car plate",
+
+		"empty":             "{field} cannot be empty",
+		"too_short":         "{field} is too short",
+		"too_long":          "{field} is too long",
+		"invalid_character": "{field} contains an invalid character at position {position}",
+		"bad_prefix":        "{field} has an invalid prefix",
+		"unknown_bank":      "Bank code not recognized",
+		"checksum":          "{field} control key is invalid",
+		"bad_format":        "{field} has an invalid format",
+		"unsupported_type":  "Unsupported {field} type",
+		"unknown_field":     "No validator is registered for {field}",
+		"invalid":           "{field} is invalid",
+
+		"cin.too_short":         "CIN must be exactly 8 digits",
+		"cin.too_long":          "CIN must be exactly 8 digits",
+		"cin.bad_prefix":        "CIN must start with 0 or 1",
+		"cin.invalid_character": "CIN must contain only digits (invalid character at position {position})",
+
+		"phone.too_short":  "Phone number must be exactly 8 digits",
+		"phone.too_long":   "Phone number must be exactly 8 digits",
+		"phone.bad_prefix": "Phone number must start with one of: {expected}",
+		"phone.bad_format": "Phone number must be 8 digits, optionally preceded by +216, without spaces",
+
+		"taxID.too_short":         "Tax ID must be exactly 16 characters long",
+		"taxID.too_long":          "Tax ID must be exactly 16 characters long",
+		"taxID.invalid_character": "Tax ID must follow the format 1234567A/P/M/000 (invalid character at position {position})",
+		"taxID.bad_format":        "Tax ID must follow the format 1234567A/P/M/000 (invalid character at position {position})",
+
+		"rib.too_short":         "RIB must be exactly 20 digits",
+		"rib.too_long":          "RIB must be exactly 20 digits",
+		"rib.invalid_character": "RIB must contain only digits (invalid character at position {position})",
+		"rib.checksum":          "RIB key is invalid (expected {expected})",
+
+		"postal.too_short":         "Postal code must be exactly 4 digits",
+		"postal.too_long":          "Postal code must be exactly 4 digits",
+		"postal.invalid_character": "Postal code must contain only digits",
+
+		"carPlate.bad_format":       "Car plate must follow the format {expected}",
+		"carPlate.unsupported_type": "Car plate type must be standard or special",
+	},
+
+	French: {
+		"field.cin":      "Le numéro de CIN",
+		"field.phone":    "Le numéro de téléphone",
+		"field.taxID":    "Le matricule fiscal",
+		"field.rib":      "Le RIB",
+		"field.postal":   "Le code postal",
+		"field.carPlate": "La plaque d'immatriculation",
+
+		"empty":             "{field} est obligatoire",
+		"too_short":         "{field} est trop court",
+		"too_long":          "{field} est trop long",
+		"invalid_character": "{field} contient un caractère invalide en position {position}",
+		"bad_prefix":        "{field} a un préfixe invalide",
+		"unknown_bank":      "Code banque inconnu",
+		"checksum":          "La clé de contrôle est invalide",
+		"bad_format":        "{field} a un format invalide",
+		"unsupported_type":  "Type non pris en charge",
+		"unknown_field":     "Aucun validateur n'est enregistré pour {field}",
+		"invalid":           "{field} est invalide",
+
+		"cin.too_short":         "Le numéro de CIN doit comporter exactement 8 chiffres",
+		"cin.too_long":          "Le numéro de CIN doit comporter exactement 8 chiffres",
+		"cin.bad_prefix":        "Le numéro de CIN doit commencer par 0 ou 1",
+		"cin.invalid_character": "Le numéro de CIN ne doit contenir que des chiffres (caractère invalide en position {position})",
+
+		"phone.too_short":  "Le numéro de téléphone doit comporter exactement 8 chiffres",
+		"phone.too_long":   "Le numéro de téléphone doit comporter exactement 8 chiffres",
+		"phone.bad_prefix": "Le numéro de téléphone doit commencer par l'un des chiffres : {expected}",
+		"phone.bad_format": "Le numéro de téléphone doit comporter 8 chiffres, éventuellement précédés de +216, sans espaces",
+
+		"taxID.too_short":         "Le matricule fiscal doit comporter exactement 16 caractères",
+		"taxID.too_long":          "Le matricule fiscal doit comporter exactement 16 caractères",
+		"taxID.invalid_character": "Le matricule fiscal doit respecter le format 1234567A/P/M/000 (caractère invalide en position {position})",
+		"taxID.bad_format":        "Le matricule fiscal doit respecter le format 1234567A/P/M/000 (caractère invalide en position {position})",
+
+		"rib.too_short":         "Le RIB doit comporter exactement 20 chiffres",
+		"rib.too_long":          "Le RIB doit comporter exactement 20 chiffres",
+		"rib.invalid_character": "Le RIB ne doit contenir que des chiffres (caractère invalide en position {position})",
+		"rib.checksum":          "La clé RIB est invalide (clé attendue : {expected})",
+
+		"postal.too_short":         "Le code postal doit comporter exactement 4 chiffres",
+		"postal.too_long":          "Le code postal doit comporter exactement 4 chiffres",
+		"postal.invalid_character": "Le code postal ne doit contenir que des chiffres",
+
+		"carPlate.bad_format":       "La plaque d'immatriculation doit respecter le format {expected}",
+		"carPlate.unsupported_type": "Le type de plaque doit être standard ou special",
+	},
+
+	Arabic: {
+		"field.cin":      "رقم بطاقة التعريف الوطنية",
+		"field.phone":    "رقم الهاتف",
+		"field.taxID":    "المعرف الجبائي",
+		"field.rib":      "رقم الحساب البنكي",
+		"field.postal":   "الرمز البريدي",
+		"field.carPlate": "رقم اللوحة المنجمية",
+
+		"empty":             "{field} إجباري",
+		"too_short":         "{field} قصير جدًا",
+		"too_long":          "{field} طويل جدًا",
+		"invalid_character": "{field} يحتوي على رمز غير صالح في الموضع {position}",
+		"bad_prefix":        "بادئة {field} غير صالحة",
+		"unknown_bank":      "رمز البنك غير معروف",
+		"checksum":          "مفتاح التحقق غير صحيح",
+		"bad_format":        "صيغة {field} غير صالحة",
+		"unsupported_type":  "نوع غير مدعوم",
+		"unknown_field":     "لا يوجد مدقق مسجل للحقل {field}",
+		"invalid":           "{field} غير صالح",
+
+		"cin.too_short":         "يجب أن يتكون رقم بطاقة التعريف الوطنية من 8 أرقام بالضبط",
+		"cin.too_long":          "يجب أن يتكون رقم بطاقة التعريف الوطنية من 8 أرقام بالضبط",
+		"cin.bad_prefix":        "يجب أن يبدأ رقم بطاقة التعريف الوطنية بـ 0 أو 1",
+		"cin.invalid_character": "يجب أن يحتوي رقم بطاقة التعريف الوطنية على أرقام فقط (رمز غير صالح في الموضع {position})",
+
+		"phone.too_short":  "يجب أن يتكون رقم الهاتف من 8 أرقام بالضبط",
+		"phone.too_long":   "يجب أن يتكون رقم الهاتف من 8 أرقام بالضبط",
+		"phone.bad_prefix": "يجب أن يبدأ رقم الهاتف بأحد الأرقام التالية: {expected}",
+		"phone.bad_format": "يجب أن يتكون رقم الهاتف من 8 أرقام، مسبوقة اختياريًا بـ +216، دون فراغات",
+
+		"taxID.too_short":         "يجب أن يتكون المعرف الجبائي من 16 رمزًا بالضبط",
+		"taxID.too_long":          "يجب أن يتكون المعرف الجبائي من 16 رمزًا بالضبط",
+		"taxID.invalid_character": "يجب أن يحترم المعرف الجبائي الصيغة 1234567A/P/M/000 (رمز غير صالح في الموضع {position})",
+		"taxID.bad_format":        "يجب أن يحترم المعرف الجبائي الصيغة 1234567A/P/M/000 (رمز غير صالح في الموضع {position})",
+
+		"rib.too_short":         "يجب أن يتكون رقم الحساب البنكي من 20 رقمًا بالضبط",
+		"rib.too_long":          "يجب أن يتكون رقم الحساب البنكي من 20 رقمًا بالضبط",
+		"rib.invalid_character": "يجب أن يحتوي رقم الحساب البنكي على أرقام فقط (رمز غير صالح في الموضع {position})",
+		"rib.checksum":          "مفتاح رقم الحساب البنكي غير صحيح (المفتاح المتوقع: {expected})",
+
+		"postal.too_short":         "يجب أن يتكون الرمز البريدي من 4 أرقام بالضبط",
+		"postal.too_long":          "يجب أن يتكون الرمز البريدي من 4 أرقام بالضبط",
+		"postal.invalid_character": "يجب أن يحتوي الرمز البريدي على أرقام فقط",
+
+		"carPlate.bad_format":       "يجب أن يحترم رقم اللوحة المنجمية الصيغة {expected}",
+		"carPlate.unsupported_type": "يجب أن يكون نوع اللوحة المنجمية standard أو special",
+	},
+}
@@ -0,0 +1,340 @@
+// Package identify guesses which Tunisian identifier an unknown string is.
+//
+// Identify checks a value against the rules of every kind of identifier (the
+// registered validators, the IBAN and payment card check digits, landline
+// area codes) and returns the kinds it could be, most likely first, with the
+// canonical value and the metadata found by the lookups (carrier, bank,
+// governorate, ...). ProfileColumn infers the kind of a column without a
+// header from a sample of its values.
+//
+// Example usage:
+//
+//	for _, c := range identify.Identify("+216 98 123 456") {
+//	    fmt.Println(c.Kind, c.Confidence, c.Metadata["carrier"]) // mobile 0.95 Tunisie Telecom
+//	}
+//
+//	profile := identify.ProfileColumn(sample)
+//	fmt.Println(profile.Kind) // e.g. "rib"
+package identify
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/normalize"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// Kinds of identifiers
+const (
+	KindCIN      = "cin"
+	KindMobile   = "mobile"
+	KindLandline = "landline"
+	KindRIB      = "rib"
+	KindIBAN     = "iban"
+	KindTaxID    = "taxID"
+	KindPostal   = "postal"
+	KindCarPlate = "carPlate"
+	KindCard     = "card"
+)
+
+// Candidate is a kind of identifier a value may be
+type Candidate struct {
+	// Kind is the kind of identifier (KindCIN, KindMobile, ...)
+	Kind string `json:"kind"`
+	// Confidence estimates, between 0 and 1, how likely the value is an identifier of this kind
+	Confidence float64 `json:"confidence"`
+	// Normalized is the canonical form of the value (E.164 for phone numbers)
+	Normalized string `json:"normalized"`
+	// Metadata holds what the lookups found (carrier, bank, governorate, card network, ...)
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Confidence of the candidates. A value that passes distinctive rules (check
+// digits, a Tax ID layout, the country code) is more likely of that kind
+// than one that is merely 4 or 8 digits long.
+const (
+	cinConfidence           = 0.6
+	mobileConfidence        = 0.7
+	landlineConfidence      = 0.6
+	areaCodeBoost           = 0.05 // landline with a known area code
+	internationalConfidence = 0.95 // phone number written with the country code
+	ribConfidence           = 0.6
+	ribKeyConfidence        = 0.9 // RIB whose key is correct
+	ibanConfidence          = 0.99
+	taxIDConfidence         = 0.95
+	postalConfidence        = 0.4
+	mainPostalConfidence    = 0.6 // main postal code of a governorate
+	carPlateConfidence      = 0.95
+	cardConfidence          = 0.5
+	cardNetworkConfidence   = 0.8 // card number with the prefix of a known network
+)
+
+// identifiers list the checks in the order used to rank candidates of equal confidence
+var identifiers = []func(s string) *Candidate{
+	identifyTaxID,
+	identifyCarPlate,
+	identifyIBAN,
+	identifyRIB,
+	identifyPhoneNumber,
+	identifyCIN,
+	identifyCard,
+	identifyPostalCode,
+}
+
+// Identify returns the kinds of identifier s may be, most likely first
+//
+// Parameters:
+//   - s: The value, in any format accepted by the validators (Arabic-Indic digits, spaces, ...)
+//
+// Returns:
+//   - []Candidate: the candidates by decreasing confidence, empty if s matches no kind
+//
+// Example:
+//
+//	candidates := Identify("01234567890123456789")
+//	fmt.Println(candidates[0].Kind, candidates[0].Metadata["bank"]) // rib Banque Centrale de Tunisie
+func Identify(s string) []Candidate {
+	s = normalize.String(s)
+	if s == "" {
+		return nil
+	}
+
+	var candidates []Candidate
+	for _, identify := range identifiers {
+		if c := identify(s); c != nil {
+			c.Confidence = round(c.Confidence)
+			candidates = append(candidates, *c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return candidates
+}
+
+// validated returns a candidate of kind if the registered validator named
+// name accepts value, with the canonical value and the metadata it provides
+func validated(kind, name, value string, opts validators.Options, confidence float64) *Candidate {
+	v, ok := validators.Lookup(name)
+	if !ok {
+		return nil
+	}
+	if a, ok := v.(validators.Analyzer); ok {
+		normalized, metadata, err := a.Analyze(value, opts)
+		if err != nil {
+			return nil
+		}
+		return &Candidate{Kind: kind, Confidence: confidence, Normalized: normalized, Metadata: metadata}
+	}
+	if v.Check(value, opts) != nil {
+		return nil
+	}
+
+	c := &Candidate{Kind: kind, Confidence: confidence, Normalized: value}
+	if n, ok := v.(validators.Normalizer); ok {
+		if normalized, err := n.Normalize(value, opts); err == nil {
+			c.Normalized = normalized
+		}
+	}
+	if d, ok := v.(validators.Describer); ok {
+		c.Metadata = d.Describe(value, opts)
+	}
+	return c
+}
+
+// compact removes the spaces, dots and dashes that group digits
+func compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '.' || r == '-' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func identifyCIN(s string) *Candidate {
+	return validated(KindCIN, "cin", s, validators.Options{}, cinConfidence)
+}
+
+// identifyTaxID identifies Tax IDs, in lower case too
+func identifyTaxID(s string) *Candidate {
+	taxID, err := types.ParseTaxID(s)
+	if err != nil {
+		return nil
+	}
+	return validated(KindTaxID, "taxID", string(taxID), validators.Options{}, taxIDConfidence)
+}
+
+func identifyCarPlate(s string) *Candidate {
+	if c := validated(KindCarPlate, "carPlate", s, validators.Options{}, carPlateConfidence); c != nil {
+		return c
+	}
+	special := validators.Options{Params: map[string]string{"type": "special"}}
+	return validated(KindCarPlate, "carPlate", s, special, carPlateConfidence)
+}
+
+// identifyRIB identifies RIBs, grouped digits too
+func identifyRIB(s string) *Candidate {
+	rib, err := types.ParseRIB(s)
+	if err != nil {
+		return nil
+	}
+	c := validated(KindRIB, "rib", string(rib), validators.Options{}, ribConfidence)
+	if c != nil && validators.ValidateRIBChecksum(string(rib)) {
+		c.Confidence = ribKeyConfidence
+	}
+	return c
+}
+
+func identifyPostalCode(s string) *Candidate {
+	c := validated(KindPostal, "postal", s, validators.Options{}, postalConfidence)
+	if c != nil && validators.IsMainGovernoratePostalCode(s) {
+		c.Confidence = mainPostalConfidence
+	}
+	return c
+}
+
+// landlineAreas maps the area codes of landlines to the governorates they serve
+var landlineAreas = map[string]string{
+	"71": "Tunis, Ariana, Ben Arous, Manouba",
+	"72": "Bizerte, Nabeul, Zaghouan",
+	"73": "Sousse, Monastir, Mahdia",
+	"74": "Sfax",
+	"75": "Gabès, Médenine, Tataouine",
+	"76": "Gafsa, Tozeur, Kébili",
+	"77": "Kairouan, Kasserine, Sidi Bouzid",
+	"78": "Béja, Jendouba, Le Kef, Siliana",
+}
+
+// landlinePrefixes are the first digits of landline numbers
+var landlinePrefixes = []string{"3", "7"}
+
+// identifyPhoneNumber identifies mobile and landline numbers, with or without the country code
+func identifyPhoneNumber(s string) *Candidate {
+	national := compact(s)
+	international := false
+	for _, prefix := range []string{constants.CountryCode, "00" + constants.CountryCode[1:]} {
+		if strings.HasPrefix(national, prefix) {
+			national, international = national[len(prefix):], true
+			break
+		}
+	}
+	if len(national) != 8 || !isDigits(national) {
+		return nil
+	}
+
+	confidence := mobileConfidence
+	if international {
+		confidence = internationalConfidence
+	}
+	if c := validated(KindMobile, "phone", national, validators.Options{}, confidence); c != nil {
+		return c
+	}
+
+	for _, prefix := range landlinePrefixes {
+		if !strings.HasPrefix(national, prefix) {
+			continue
+		}
+		c := &Candidate{Kind: KindLandline, Confidence: landlineConfidence, Normalized: constants.CountryCode + national}
+		if area, ok := landlineAreas[national[:2]]; ok {
+			c.Confidence += areaCodeBoost
+			c.Metadata = map[string]string{"areaCode": national[:2], "area": area}
+		}
+		if international {
+			c.Confidence = internationalConfidence
+		}
+		return c
+	}
+	return nil
+}
+
+// identifyIBAN identifies Tunisian IBANs: "TN", 2 check digits (ISO 7064 mod 97-10) and a RIB
+func identifyIBAN(s string) *Candidate {
+	iban := strings.ToUpper(compact(s))
+	if len(iban) != 24 || !strings.HasPrefix(iban, "TN") || !isDigits(iban[2:]) || ibanRemainder(iban) != 1 {
+		return nil
+	}
+
+	rib := types.RIB(iban[4:])
+	if rib.Validate() != nil {
+		return nil
+	}
+	c := &Candidate{Kind: KindIBAN, Confidence: ibanConfidence, Normalized: iban}
+	if info := rib.Bank(); info != nil {
+		c.Metadata = map[string]string{"bank": info.Bank.Name, "bankCode": info.Code, "rib": string(rib)}
+	}
+	return c
+}
+
+// ibanRemainder returns the ISO 7064 mod 97-10 remainder of an IBAN, 1 if its check digits are correct
+func ibanRemainder(iban string) int {
+	remainder := 0
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder
+}
+
+// identifyCard identifies payment card numbers: 13 to 19 digits with a correct Luhn check digit
+func identifyCard(s string) *Candidate {
+	number := compact(s)
+	if len(number) < 13 || len(number) > 19 || !isDigits(number) || !luhn(number) {
+		return nil
+	}
+
+	c := &Candidate{Kind: KindCard, Confidence: cardConfidence, Normalized: number}
+	if network := cardNetwork(number); network != "" {
+		c.Confidence = cardNetworkConfidence
+		c.Metadata = map[string]string{"network": network}
+	}
+	return c
+}
+
+// cardNetwork returns the network of a card number from its first digits, or an empty string
+func cardNetwork(number string) string {
+	prefix2, _ := strconv.Atoi(number[:2])
+	prefix4, _ := strconv.Atoi(number[:4])
+	switch {
+	case number[0] == '4':
+		return "Visa"
+	case (prefix2 >= 51 && prefix2 <= 55) || (prefix4 >= 2221 && prefix4 <= 2720):
+		return "Mastercard"
+	case prefix2 == 34 || prefix2 == 37:
+		return "American Express"
+	}
+	return ""
+}
+
+// luhn reports whether the last digit of number is its Luhn check digit
+func luhn(number string) bool {
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		d := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
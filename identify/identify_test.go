@@ -0,0 +1,121 @@
+package identify
+
+import (
+	"testing"
+
+	"github.com/degache-go/degache/fake"
+)
+
+func TestIdentify(t *testing.T) {
+	tests := []struct {
+		value      string
+		kind       string
+		confidence float64
+		normalized string
+		metadata   map[string]string
+	}{
+		{"12345678", KindCIN, 0.6, "12345678", nil},
+		{"١٢٣٤٥٦٧٨", KindCIN, 0.6, "12345678", nil},
+		{"20123456", KindMobile, 0.7, "+21620123456", map[string]string{"carrier": "Ooredoo Tunisia"}},
+		{"+216 98 123 456", KindMobile, 0.95, "+21698123456", map[string]string{"carrier": "Tunisie Telecom", "prefix": "9"}},
+		{"0021655123456", KindMobile, 0.95, "+21655123456", nil},
+		{"71 123 456", KindLandline, 0.65, "+21671123456", map[string]string{"areaCode": "71"}},
+		{"31.123.456", KindLandline, 0.6, "+21631123456", nil},
+		{"+216 74 123 456", KindLandline, 0.95, "+21674123456", map[string]string{"area": "Sfax"}},
+		{"01234567890123456789", KindRIB, 0.6, "01234567890123456789", map[string]string{"bank": "Banque Centrale de Tunisie"}},
+		{"01234567890123456706", KindRIB, 0.9, "01234567890123456706", map[string]string{"key": "06"}},
+		{"01 234 5678901234567 06", KindRIB, 0.9, "01234567890123456706", map[string]string{"key": "06"}},
+		{"tn80 0123 4567 8901 2345 6789", KindIBAN, 0.99, "TN8001234567890123456789", map[string]string{"bankCode": "01"}},
+		{"1234567A/P/M/000", KindTaxID, 0.95, "1234567A/P/M/000", map[string]string{"type2": "P"}},
+		{"1234567a/p/m/000", KindTaxID, 0.95, "1234567A/P/M/000", map[string]string{"type2": "P"}},
+		{"1000", KindPostal, 0.6, "1000", map[string]string{"governorate": "Tunis"}},
+		{"1001", KindPostal, 0.4, "1001", nil},
+		{"123 تونس 4567", KindCarPlate, 0.95, "123 تونس 4567", map[string]string{"type": "standard"}},
+		{"RS 123 تونس", KindCarPlate, 0.95, "RS 123 تونس", map[string]string{"type": "special"}},
+		{"4111 1111 1111 1111", KindCard, 0.8, "4111111111111111", map[string]string{"network": "Visa"}},
+		{"2221000000000009", KindCard, 0.8, "2221000000000009", map[string]string{"network": "Mastercard"}},
+		{"378282246310005", KindCard, 0.8, "378282246310005", map[string]string{"network": "American Express"}},
+		{"6011111111111117", KindCard, 0.5, "6011111111111117", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			candidates := Identify(tt.value)
+			if len(candidates) == 0 {
+				t.Fatalf("Identify(%q) found nothing, want %s", tt.value, tt.kind)
+			}
+			c := candidates[0]
+			if c.Kind != tt.kind || c.Confidence != tt.confidence || c.Normalized != tt.normalized {
+				t.Errorf("Identify(%q)[0] = %s %v %s, want %s %v %s", tt.value,
+					c.Kind, c.Confidence, c.Normalized, tt.kind, tt.confidence, tt.normalized)
+			}
+			for key, want := range tt.metadata {
+				if got := c.Metadata[key]; got != want {
+					t.Errorf("Identify(%q)[0].Metadata[%q] = %q, want %q", tt.value, key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentifyNothing(t *testing.T) {
+	for _, value := range []string{"", "  ", "hello", "60123456", "99234567890123456789",
+		"TN8101234567890123456789", "4111111111111112", "1234567A-P-M-000", "123456789"} {
+		if candidates := Identify(value); len(candidates) > 0 {
+			t.Errorf("Identify(%q) = %+v, want no candidate", value, candidates)
+		}
+	}
+}
+
+// TestIdentifyGenerated checks that generated identifiers are identified as their kind first
+func TestIdentifyGenerated(t *testing.T) {
+	g := fake.New(1)
+	kinds := map[string]string{
+		"cin":      KindCIN,
+		"phone":    KindMobile,
+		"taxID":    KindTaxID,
+		"rib":      KindRIB,
+		"postal":   KindPostal,
+		"carPlate": KindCarPlate,
+	}
+	for i := 0; i < 100; i++ {
+		for kind, want := range kinds {
+			value, err := g.Value(kind)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if candidates := Identify(value); len(candidates) == 0 || candidates[0].Kind != want {
+				t.Errorf("Identify(%q) = %+v, want %s first", value, candidates, want)
+			}
+		}
+	}
+}
+
+func TestProfileColumn(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []string
+		kind       string
+		confidence float64
+		blank      int
+	}{
+		{"phones", []string{"20 123 456", "+216 98 123 456", "", "55123456"}, KindMobile, 0.78, 1},
+		{"mixed phones", []string{"20123456", "98123456", "71123456"}, KindMobile, 0.47, 0},
+		{"RIBs with one typo", []string{"01234567890123456706", "01234567890123456789", "0123456789012345678"}, KindRIB, 0.5, 0},
+		{"postal codes", []string{"1000", "3000", "2080"}, KindPostal, 0.53, 0},
+		{"postal codes and a few IBANs", []string{"1000", "3000", "2080", "4000", "5000",
+			"TN90 0123 4567 8901 2345 6706", "TN9001234567890123456706", "TN9001234567890123456706", "TN9001234567890123456706"}, KindPostal, 0.31, 0},
+		{"unknown", []string{"hello", "world", "12345678"}, "", 0, 0},
+		{"blank", []string{"", " "}, "", 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := ProfileColumn(tt.values)
+			if p.Kind != tt.kind || p.Confidence != tt.confidence || p.Blank != tt.blank {
+				t.Errorf("ProfileColumn(%q) = %s %v blank %d, want %s %v blank %d (%+v)",
+					tt.values, p.Kind, p.Confidence, p.Blank, tt.kind, tt.confidence, tt.blank, p.Kinds)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package identify
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// minShare is the share of the values a kind must match to be the kind of a column
+const minShare = 0.5
+
+// KindScore summarizes how well the values of a column match a kind
+type KindScore struct {
+	// Kind is the kind of identifier
+	Kind string `json:"kind"`
+	// Matches is the number of values that may be of this kind
+	Matches int `json:"matches"`
+	// Share is Matches divided by the number of non-blank values
+	Share float64 `json:"share"`
+	// Confidence is the mean confidence of the kind over the non-blank values (0 for values that do not match)
+	Confidence float64 `json:"confidence"`
+}
+
+// ColumnProfile is the inferred kind of a column of values
+type ColumnProfile struct {
+	// Kind is the best ranked kind among those matching at least half of the values, empty if there is none
+	Kind string `json:"kind,omitempty"`
+	// Confidence is the confidence of Kind
+	Confidence float64 `json:"confidence"`
+	// Values is the number of non-blank values
+	Values int `json:"values"`
+	// Blank is the number of blank values, which are ignored
+	Blank int `json:"blank"`
+	// Kinds ranks every kind matched by at least one value, by decreasing confidence
+	Kinds []KindScore `json:"kinds"`
+}
+
+// ProfileColumn infers the kind of a column from a sample of its values
+//
+// Parameters:
+//   - values: The values of the column, or a sample of them
+//
+// Returns:
+//   - ColumnProfile: the inferred kind and the score of every kind matched
+//
+// Example:
+//
+//	profile := ProfileColumn([]string{"20 123 456", "+216 98 123 456", "", "55123456"})
+//	fmt.Println(profile.Kind, profile.Confidence) // mobile 0.78
+func ProfileColumn(values []string) ColumnProfile {
+	var profile ColumnProfile
+	scores := map[string]*KindScore{}
+	sums := map[string]float64{}
+
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" {
+			profile.Blank++
+			continue
+		}
+		profile.Values++
+
+		for _, c := range Identify(value) {
+			score, ok := scores[c.Kind]
+			if !ok {
+				score = &KindScore{Kind: c.Kind}
+				scores[c.Kind] = score
+			}
+			score.Matches++
+			sums[c.Kind] += c.Confidence
+		}
+	}
+
+	profile.Kinds = make([]KindScore, 0, len(scores))
+	for kind, score := range scores {
+		score.Share = round(float64(score.Matches) / float64(profile.Values))
+		score.Confidence = round(sums[kind] / float64(profile.Values))
+		profile.Kinds = append(profile.Kinds, *score)
+	}
+	sort.Slice(profile.Kinds, func(i, j int) bool {
+		a, b := profile.Kinds[i], profile.Kinds[j]
+		if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		if a.Matches != b.Matches {
+			return a.Matches > b.Matches
+		}
+		return a.Kind < b.Kind
+	})
+
+	// The kind of the column is the best ranked among those matching enough values
+	for _, score := range profile.Kinds {
+		if score.Share >= minShare {
+			profile.Kind = score.Kind
+			profile.Confidence = score.Confidence
+			break
+		}
+	}
+	return profile
+}
+
+// round rounds x to 2 decimals
+func round(x float64) float64 {
+	return math.Round(x*100) / 100
+}
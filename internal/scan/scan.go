@@ -0,0 +1,50 @@
+// Package scan holds the byte-level rules shared by the types and validators
+// packages, so that the detailed Validate methods and the allocation-free
+// Validate* functions cannot drift apart.
+package scan
+
+// TaxIDLayout describes a Tax ID: 9 is a digit, A an uppercase letter and any other byte a literal
+const TaxIDLayout = "9999999A/A/A/999"
+
+// IsDigit reports whether c is an ASCII digit
+func IsDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// IsDigits reports whether s contains only ASCII digits
+func IsDigits(s string) bool {
+	return FirstNonDigit(s) < 0
+}
+
+// FirstNonDigit returns the index of the first byte of s that is not an ASCII digit, or -1
+func FirstNonDigit(s string) int {
+	for i := 0; i < len(s); i++ {
+		if !IsDigit(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MatchesLayoutAt reports whether c matches position i of layout
+func MatchesLayoutAt(c byte, layout string, i int) bool {
+	switch layout[i] {
+	case '9':
+		return IsDigit(c)
+	case 'A':
+		return c >= 'A' && c <= 'Z'
+	default:
+		return c == layout[i]
+	}
+}
+
+// LayoutMismatch returns the index of the first byte of s that does not match
+// layout, or -1. It expects s to be as long as layout.
+func LayoutMismatch(s, layout string) int {
+	for i := 0; i < len(layout); i++ {
+		if !MatchesLayoutAt(s[i], layout, i) {
+			return i
+		}
+	}
+	return -1
+}
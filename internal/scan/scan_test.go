@@ -0,0 +1,40 @@
+package scan
+
+import "testing"
+
+func TestLayoutMismatch(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"1234567A/P/M/000", -1},
+		{"123456aA/P/M/000", 6},
+		{"1234567a/P/M/000", 7},
+		{"1234567A-P/M/000", 8},
+		{"1234567A/P/M/00x", 15},
+	}
+
+	for _, tt := range tests {
+		if result := LayoutMismatch(tt.input, TaxIDLayout); result != tt.expected {
+			t.Errorf("LayoutMismatch(%q) = %d, want %d", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestFirstNonDigit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"", -1},
+		{"0123456789", -1},
+		{"12a4", 2},
+		{"١٢", 0},
+	}
+
+	for _, tt := range tests {
+		if result := FirstNonDigit(tt.input); result != tt.expected {
+			t.Errorf("FirstNonDigit(%q) = %d, want %d", tt.input, result, tt.expected)
+		}
+	}
+}
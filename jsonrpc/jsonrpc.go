@@ -0,0 +1,701 @@
+// Package jsonrpc exposes the functions of packages validators and formatters
+// over JSON-RPC 2.0, so that programs written in other languages can use them
+// through a long-running "degache rpc" process instead of an HTTP server.
+//
+// Messages are exchanged one per line: every line read is a request, a
+// notification or a batch (a JSON array of requests), and every response or
+// batch of responses is written on its own line.
+//
+// Methods are named after the Go functions, e.g. "validators.ValidateCIN" or
+// "formatters.FormatCurrency". Parameters are given by position or by name,
+// option structs as JSON objects and dates as RFC 3339 or "2006-01-02" strings:
+//
+//	{"jsonrpc":"2.0","id":1,"method":"validators.ValidatePhoneNumber","params":["20 123 456",{"strict":true}]}
+//	{"jsonrpc":"2.0","id":2,"method":"formatters.FormatCurrency","params":{"amount":1234.5,"options":{"code":true}}}
+//
+// Functions returning several values return a JSON array; functions returning
+// an error report it as a JSON-RPC error whose data is the *types.ValidationError,
+// if any. "rpc.methods" lists the methods and "rpc.shutdown" stops the server
+// once the pending requests are answered.
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/formatters"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// MaxMessageBytes is the maximum length of a message line
+const MaxMessageBytes = 16 << 20
+
+// Standard and server-defined error codes
+const (
+	CodeParseError     = -32700 // the line is not valid JSON
+	CodeInvalidRequest = -32600 // the message is not a valid request
+	CodeMethodNotFound = -32601 // the method does not exist
+	CodeInvalidParams  = -32602 // the parameters do not match the method
+	CodeInternalError  = -32603 // the method panicked
+	CodeFailed         = -32000 // the function returned an error (e.g. a validation failure)
+)
+
+// Request is a JSON-RPC 2.0 request or notification (without ID)
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC 2.0 response
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler: a response has either a result, possibly null, or an error
+func (r Response) MarshalJSON() ([]byte, error) {
+	var v any = struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  any             `json:"result"`
+	}{r.JSONRPC, r.ID, r.Result}
+	if r.Error != nil {
+		v = struct {
+			JSONRPC string          `json:"jsonrpc"`
+			ID      json.RawMessage `json:"id"`
+			Error   *Error          `json:"error"`
+		}{r.JSONRPC, r.ID, r.Error}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// Error is a JSON-RPC 2.0 error object
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("jsonrpc: %s (%d)", e.Message, e.Code)
+}
+
+// MethodInfo describes a method, as listed by "rpc.methods"
+type MethodInfo struct {
+	Name   string   `json:"name"`
+	Params []string `json:"params"`
+}
+
+// method is a Go function callable over JSON-RPC
+type method struct {
+	fn     reflect.Value
+	params []string
+	// context is true if the first argument of fn is a context.Context, which is not a JSON parameter
+	context bool
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Server answers JSON-RPC requests. A Server is safe for concurrent use.
+type Server struct {
+	methods map[string]*method
+
+	mu sync.Mutex
+	// shutdown is closed by "rpc.shutdown" to stop the running Serve calls,
+	// then replaced so that the Server can serve again
+	shutdown chan struct{}
+}
+
+// NewServer creates a server exposing the functions of validators and formatters
+//
+// Returns:
+//   - *Server: the server
+//
+// Example:
+//
+//	err := jsonrpc.NewServer().Serve(ctx, os.Stdin, os.Stdout)
+func NewServer() *Server {
+	s := &Server{methods: make(map[string]*method), shutdown: make(chan struct{})}
+
+	// Functions taking or returning Go values (NewValidator, Register,
+	// ValidateStruct, ...) have no JSON form and are not exposed. Lookups
+	// return the camelCase types of this package.
+	s.register("validators.ValidateCIN", validators.ValidateCIN, "cin", "options")
+	s.register("validators.ValidateCINWithDetails", validators.ValidateCINWithDetails, "cin", "options")
+	s.register("validators.CheckCIN", validators.CheckCIN, "cin", "options")
+	s.register("validators.ValidatePhoneNumber", validators.ValidatePhoneNumber, "phoneNumber", "options")
+	s.register("validators.ValidatePhoneNumberWithDetails", validators.ValidatePhoneNumberWithDetails, "phoneNumber", "options")
+	s.register("validators.CheckPhoneNumber", validators.CheckPhoneNumber, "phoneNumber", "options")
+	s.register("validators.GetCarrierInfo", getCarrierInfo, "phoneNumber", "options")
+	s.register("validators.ValidateTaxID", validators.ValidateTaxID, "taxID", "options")
+	s.register("validators.ValidateTaxIDWithDetails", validators.ValidateTaxIDWithDetails, "taxID", "options")
+	s.register("validators.CheckTaxID", validators.CheckTaxID, "taxID", "options")
+	s.register("validators.ExtractTaxIDComponents", validators.ExtractTaxIDComponents, "taxID")
+	s.register("validators.ValidateRIB", validators.ValidateRIB, "rib", "options")
+	s.register("validators.ValidateRIBWithDetails", validators.ValidateRIBWithDetails, "rib", "options")
+	s.register("validators.CheckRIB", validators.CheckRIB, "rib", "options")
+	s.register("validators.ValidateRIBChecksum", validators.ValidateRIBChecksum, "rib", "options")
+	s.register("validators.CheckRIBChecksum", validators.CheckRIBChecksum, "rib", "options")
+	s.register("validators.GetBankFromRIB", getBankFromRIB, "rib")
+	s.register("validators.ExtractRIBComponents", validators.ExtractRIBComponents, "rib")
+	s.register("validators.ValidatePostalCode", validators.ValidatePostalCode, "postalCode", "options")
+	s.register("validators.ValidatePostalCodeWithDetails", validators.ValidatePostalCodeWithDetails, "postalCode", "options")
+	s.register("validators.CheckPostalCode", validators.CheckPostalCode, "postalCode", "options")
+	s.register("validators.GetGovernorateFromPostalCode", getGovernorateFromPostalCode, "postalCode")
+	s.register("validators.GetGovernorateByName", getGovernorateByName, "name")
+	s.register("validators.IsMainGovernoratePostalCode", validators.IsMainGovernoratePostalCode, "postalCode")
+	s.register("validators.ValidateCarPlate", validators.ValidateCarPlate, "carPlate", "options")
+	s.register("validators.ValidateCarPlateWithDetails", validators.ValidateCarPlateWithDetails, "carPlate", "options")
+	s.register("validators.CheckCarPlate", validators.CheckCarPlate, "carPlate", "options")
+	s.register("validators.GetCarPlateInfo", getCarPlateInfo, "carPlate", "options")
+	s.register("validators.Normalize", validators.Normalize, "s")
+	s.register("validators.Syntaxes", validators.Syntaxes)
+	s.register("validators.SyntaxOf", validators.SyntaxOf, "name")
+	s.register("validators.ValidateBatch", validateBatch, "records", "options")
+
+	s.register("formatters.FormatPhoneNumber", formatters.FormatPhoneNumber, "phoneNumber")
+	s.register("formatters.FormatPhoneNumberNational", formatters.FormatPhoneNumberNational, "phoneNumber")
+	s.register("formatters.FormatPhoneNumberCompact", formatters.FormatPhoneNumberCompact, "phoneNumber")
+	s.register("formatters.NormalizePhoneNumber", formatters.NormalizePhoneNumber, "phoneNumber")
+	s.register("formatters.FormatCurrency", formatters.FormatCurrency, "amount", "options")
+	s.register("formatters.FormatCurrencyCompact", formatters.FormatCurrencyCompact, "amount")
+	s.register("formatters.ParseCurrency", formatters.ParseCurrency, "currencyStr")
+	s.register("formatters.ConvertMillimesToDinars", formatters.ConvertMillimesToDinars, "millimes")
+	s.register("formatters.ConvertDinarsToMillimes", formatters.ConvertDinarsToMillimes, "dinars")
+	s.register("formatters.FormatDate", formatters.FormatDate, "date")
+	s.register("formatters.FormatDateShort", formatters.FormatDateShort, "date")
+	s.register("formatters.FormatDateNumeric", formatters.FormatDateNumeric, "date")
+	s.register("formatters.FormatDateTime", formatters.FormatDateTime, "dateTime")
+	s.register("formatters.FormatDateTimeShort", formatters.FormatDateTimeShort, "dateTime")
+	s.register("formatters.FormatTime", formatters.FormatTime, "t")
+	s.register("formatters.FormatTime12Hour", formatters.FormatTime12Hour, "t")
+	s.register("formatters.GetTunisianMonthName", formatters.GetTunisianMonthName, "month")
+	s.register("formatters.GetTunisianWeekdayName", formatters.GetTunisianWeekdayName, "weekday")
+	s.register("formatters.Format", formatters.Format, "kind", "style", "value")
+	s.register("formatters.MaskCIN", formatters.MaskCIN, "cin", "policy")
+	s.register("formatters.MaskPhoneNumber", formatters.MaskPhoneNumber, "phoneNumber", "policy")
+	s.register("formatters.MaskRIB", formatters.MaskRIB, "rib", "policy")
+	s.register("formatters.MaskTaxID", formatters.MaskTaxID, "taxID", "policy")
+
+	s.register("rpc.methods", s.Methods)
+	s.register("rpc.shutdown", func() any {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		close(s.shutdown)
+		s.shutdown = make(chan struct{})
+		return nil
+	})
+
+	return s
+}
+
+// register exposes fn under name; params names its JSON parameters. The
+// variadic options parameter of a function is optional.
+func (s *Server) register(name string, fn any, params ...string) {
+	v := reflect.ValueOf(fn)
+	m := &method{fn: v, params: params}
+	m.context = v.Type().NumIn() > 0 && v.Type().In(0) == contextType
+
+	want := v.Type().NumIn()
+	if m.context {
+		want--
+	}
+	if len(params) != want {
+		panic(fmt.Sprintf("jsonrpc: %s takes %d parameters, %d names given", name, want, len(params)))
+	}
+	s.methods[name] = m
+}
+
+// Methods returns the exposed methods sorted by name
+func (s *Server) Methods() []MethodInfo {
+	infos := make([]MethodInfo, 0, len(s.methods))
+	for name, m := range s.methods {
+		infos = append(infos, MethodInfo{Name: name, Params: append([]string{}, m.params...)})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Serve reads messages from in, one per line, and writes the responses to out
+// until in is exhausted, ctx is done or "rpc.shutdown" is called. The message
+// being processed is always answered before Serve returns. A Server can serve
+// again after a shutdown, and "rpc.shutdown" stops every Serve call running
+// at the time.
+//
+// Parameters:
+//   - ctx: The context; cancelling it stops the server gracefully
+//   - in: The requests
+//   - out: The responses
+//
+// Returns:
+//   - error: nil on end of input, shutdown or cancellation; a read or write error otherwise
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	type line struct {
+		data []byte
+		err  error
+	}
+
+	s.mu.Lock()
+	shutdown := s.shutdown
+	s.mu.Unlock()
+
+	// The reader stops sending lines once Serve returns, whatever the reason
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Lines are read in the background so that a cancellation is noticed
+	// while waiting for input
+	lines := make(chan line)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(in)
+		scanner.Buffer(make([]byte, 0, 64*1024), MaxMessageBytes)
+		for scanner.Scan() {
+			data := append([]byte(nil), scanner.Bytes()...)
+			select {
+			case lines <- line{data: data}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			select {
+			case lines <- line{err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	writer := bufio.NewWriter(out)
+	for {
+		// A shutdown takes precedence over the lines already read
+		select {
+		case <-shutdown:
+			return nil
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-shutdown:
+			return nil
+		case l, ok := <-lines:
+			if !ok {
+				return nil
+			}
+			if l.err != nil {
+				return fmt.Errorf("jsonrpc: %w", l.err)
+			}
+
+			response := s.Handle(ctx, l.data)
+			if response == nil {
+				continue
+			}
+			if _, err := writer.Write(append(response, '\n')); err != nil {
+				return fmt.Errorf("jsonrpc: %w", err)
+			}
+			if err := writer.Flush(); err != nil {
+				return fmt.Errorf("jsonrpc: %w", err)
+			}
+		}
+	}
+}
+
+// Handle answers one message: a request, a notification or a batch. Requests
+// of a batch are processed concurrently and answered in order.
+//
+// Parameters:
+//   - ctx: The context passed to the methods
+//   - message: The JSON message
+//
+// Returns:
+//   - []byte: the JSON response, or nil if the message contains only notifications
+func (s *Server) Handle(ctx context.Context, message []byte) []byte {
+	message = bytes.TrimSpace(message)
+	if len(message) == 0 {
+		return nil
+	}
+
+	if message[0] != '[' {
+		var request Request
+		if err := json.Unmarshal(message, &request); err != nil {
+			return marshal(errorResponse(nil, parseError(err)))
+		}
+		response := s.call(ctx, request)
+		if response == nil {
+			return nil
+		}
+		return marshal(response)
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(message, &batch); err != nil {
+		return marshal(errorResponse(nil, parseError(err)))
+	}
+	if len(batch) == 0 {
+		return marshal(errorResponse(nil, &Error{Code: CodeInvalidRequest, Message: "empty batch"}))
+	}
+
+	responses := make([]*Response, len(batch))
+	limit := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, raw := range batch {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int, raw json.RawMessage) {
+			defer wg.Done()
+			defer func() { <-limit }()
+
+			var request Request
+			if err := json.Unmarshal(raw, &request); err != nil {
+				responses[i] = errorResponse(nil, &Error{Code: CodeInvalidRequest, Message: err.Error()})
+				return
+			}
+			responses[i] = s.call(ctx, request)
+		}(i, raw)
+	}
+	wg.Wait()
+
+	answered := responses[:0]
+	for _, response := range responses {
+		if response != nil {
+			answered = append(answered, response)
+		}
+	}
+	if len(answered) == 0 {
+		return nil
+	}
+	return marshal(answered)
+}
+
+// parseError converts a JSON syntax error to a JSON-RPC error
+func parseError(err error) *Error {
+	var syntaxError *json.SyntaxError
+	if errors.As(err, &syntaxError) {
+		return &Error{Code: CodeParseError, Message: "parse error: " + err.Error()}
+	}
+	return &Error{Code: CodeInvalidRequest, Message: "invalid request: " + err.Error()}
+}
+
+// call runs a request and returns its response, or nil for a notification
+func (s *Server) call(ctx context.Context, request Request) *Response {
+	notification := request.ID == nil
+	if request.JSONRPC != "2.0" || request.Method == "" {
+		return errorResponse(request.ID, &Error{Code: CodeInvalidRequest, Message: `invalid request: want "jsonrpc": "2.0" and a method`})
+	}
+
+	m, ok := s.methods[request.Method]
+	if !ok {
+		if notification {
+			return nil
+		}
+		return errorResponse(request.ID, &Error{Code: CodeMethodNotFound, Message: "method not found: " + request.Method})
+	}
+
+	result, rpcErr := m.call(ctx, request.Params)
+	if notification {
+		return nil
+	}
+	if rpcErr != nil {
+		return errorResponse(request.ID, rpcErr)
+	}
+	return &Response{JSONRPC: "2.0", ID: request.ID, Result: result}
+}
+
+// errorResponse creates an error response; a nil id is written as null
+func errorResponse(id json.RawMessage, err *Error) *Response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &Response{JSONRPC: "2.0", ID: id, Error: err}
+}
+
+// marshal encodes a response; the results of the exposed functions always encode
+func marshal(v any) []byte {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		buf.Reset()
+		_ = encoder.Encode(errorResponse(nil, &Error{Code: CodeInternalError, Message: err.Error()}))
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// call decodes the parameters, calls the function and converts its results
+func (m *method) call(ctx context.Context, params json.RawMessage) (result any, rpcErr *Error) {
+	args, rpcErr := m.arguments(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if m.context {
+		args = append([]reflect.Value{reflect.ValueOf(ctx)}, args...)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result, rpcErr = nil, &Error{Code: CodeInternalError, Message: fmt.Sprint("internal error: ", r)}
+		}
+	}()
+	results := m.fn.Call(args)
+
+	fnType := m.fn.Type()
+	if n := fnType.NumOut(); n > 0 && fnType.Out(n-1) == errorType {
+		if err, _ := results[n-1].Interface().(error); err != nil {
+			return nil, failure(err)
+		}
+		results = results[:n-1]
+	}
+
+	switch len(results) {
+	case 0:
+		return nil, nil
+	case 1:
+		return results[0].Interface(), nil
+	default:
+		values := make([]any, len(results))
+		for i, r := range results {
+			values[i] = r.Interface()
+		}
+		return values, nil
+	}
+}
+
+// failure converts an error returned by a function to a JSON-RPC error
+func failure(err error) *Error {
+	rpcErr := &Error{Code: CodeFailed, Message: err.Error()}
+	if verr := validators.AsValidationError(err); verr != nil {
+		rpcErr.Data = verr
+	}
+	return rpcErr
+}
+
+// arguments decodes the positional or named parameters into the argument values of the function
+func (m *method) arguments(params json.RawMessage) ([]reflect.Value, *Error) {
+	fnType := m.fn.Type()
+	offset := 0
+	if m.context {
+		offset = 1
+	}
+
+	raw := make([]json.RawMessage, len(m.params))
+	params = bytes.TrimSpace(params)
+	switch {
+	case len(params) == 0 || bytes.Equal(params, []byte("null")):
+	case params[0] == '[':
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err != nil {
+			return nil, invalidParams("%v", err)
+		}
+		if len(positional) > len(m.params) {
+			return nil, invalidParams("got %d parameters, want at most %d (%v)", len(positional), len(m.params), m.params)
+		}
+		copy(raw, positional)
+	case params[0] == '{':
+		var named map[string]json.RawMessage
+		if err := json.Unmarshal(params, &named); err != nil {
+			return nil, invalidParams("%v", err)
+		}
+		for i, name := range m.params {
+			raw[i] = named[name]
+			delete(named, name)
+		}
+		for name := range named {
+			return nil, invalidParams("unknown parameter %q (want %v)", name, m.params)
+		}
+	default:
+		return nil, invalidParams("params must be an array or an object")
+	}
+
+	args := make([]reflect.Value, 0, len(m.params))
+	for i, name := range m.params {
+		argType := fnType.In(offset + i)
+		variadic := fnType.IsVariadic() && offset+i == fnType.NumIn()-1
+		if variadic {
+			argType = argType.Elem()
+		}
+
+		if raw[i] == nil || bytes.Equal(raw[i], []byte("null")) {
+			if variadic {
+				continue
+			}
+			return nil, invalidParams("missing parameter %q", name)
+		}
+
+		arg := reflect.New(argType)
+		if err := decodeArgument(raw[i], arg.Interface()); err != nil {
+			return nil, invalidParams("parameter %q: %v", name, err)
+		}
+		args = append(args, arg.Elem())
+	}
+
+	return args, nil
+}
+
+// decodeArgument decodes a parameter, rejecting unknown fields of option structs.
+// Dates may also be given in one of formatters.DateLayouts.
+func decodeArgument(raw json.RawMessage, target any) error {
+	if t, ok := target.(*time.Time); ok {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		for _, layout := range formatters.DateLayouts {
+			if parsed, err := time.Parse(layout, s); err == nil {
+				*t = parsed
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid date %q: want RFC 3339 or YYYY-MM-DD", s)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
+
+// invalidParams creates an invalid params error
+func invalidParams(format string, args ...any) *Error {
+	return &Error{Code: CodeInvalidParams, Message: "invalid params: " + fmt.Sprintf(format, args...)}
+}
+
+// BatchResponse is the result of "validators.ValidateBatch"
+type BatchResponse struct {
+	Results []validators.BatchResult `json:"results"`
+	Summary validators.BatchSummary  `json:"summary"`
+}
+
+// validateBatch runs validators.ValidateBatch and collects its results in input order
+func validateBatch(ctx context.Context, records []map[string]string, options ...validators.BatchOptions) (BatchResponse, error) {
+	var opts validators.BatchOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	opts.Ordered = true
+	batch := validators.ValidateBatch(ctx, records, opts)
+
+	response := BatchResponse{Results: make([]validators.BatchResult, 0, len(records))}
+	for result := range batch.Results() {
+		response.Results = append(response.Results, result)
+	}
+	response.Summary = batch.Wait()
+	return response, response.Summary.Err
+}
+
+// Carrier is a mobile carrier, as returned by "validators.GetCarrierInfo"
+type Carrier struct {
+	Name     string   `json:"name"`
+	Prefixes []string `json:"prefixes"`
+}
+
+// CarrierInfo is the result of "validators.GetCarrierInfo"
+type CarrierInfo struct {
+	Carrier Carrier `json:"carrier"`
+	Prefix  string  `json:"prefix"`
+}
+
+// Bank is a bank, as returned by "validators.GetBankFromRIB"
+type Bank struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// BankInfo is the result of "validators.GetBankFromRIB"
+type BankInfo struct {
+	Bank Bank   `json:"bank"`
+	Code string `json:"code"`
+}
+
+// Governorate is the result of "validators.GetGovernorateFromPostalCode" and "validators.GetGovernorateByName"
+type Governorate struct {
+	Name       string `json:"name"`
+	PostalCode string `json:"postalCode"`
+	Region     string `json:"region"`
+}
+
+// CarPlateComponents are the components of a car plate
+type CarPlateComponents struct {
+	Prefix string `json:"prefix"`
+	Region string `json:"region"`
+	Suffix string `json:"suffix,omitempty"`
+}
+
+// CarPlateInfo is the result of "validators.GetCarPlateInfo"
+type CarPlateInfo struct {
+	Type       string             `json:"type"`
+	Components CarPlateComponents `json:"components"`
+}
+
+// getCarrierInfo runs validators.GetCarrierInfo
+func getCarrierInfo(phoneNumber string, options ...types.PhoneNumberValidationOptions) *CarrierInfo {
+	info := validators.GetCarrierInfo(phoneNumber, options...)
+	if info == nil {
+		return nil
+	}
+	return &CarrierInfo{Carrier: Carrier{Name: info.Carrier.Name, Prefixes: info.Carrier.Prefixes}, Prefix: info.Prefix}
+}
+
+// getBankFromRIB runs validators.GetBankFromRIB
+func getBankFromRIB(rib string) *BankInfo {
+	info := validators.GetBankFromRIB(rib)
+	if info == nil {
+		return nil
+	}
+	return &BankInfo{Bank: Bank{Name: info.Bank.Name, Code: info.Bank.Code}, Code: info.Code}
+}
+
+// getGovernorateFromPostalCode runs validators.GetGovernorateFromPostalCode
+func getGovernorateFromPostalCode(postalCode string) *Governorate {
+	return governorate(validators.GetGovernorateFromPostalCode(postalCode))
+}
+
+// getGovernorateByName runs validators.GetGovernorateByName
+func getGovernorateByName(name string) *Governorate {
+	return governorate(validators.GetGovernorateByName(name))
+}
+
+// governorate converts a governorate, which may be nil
+func governorate(g *constants.Governorate) *Governorate {
+	if g == nil {
+		return nil
+	}
+	return &Governorate{Name: g.Name, PostalCode: g.PostalCode, Region: g.Region}
+}
+
+// getCarPlateInfo runs validators.GetCarPlateInfo
+func getCarPlateInfo(carPlate string, options ...types.CarPlateValidationOptions) *CarPlateInfo {
+	info := validators.GetCarPlateInfo(carPlate, options...)
+	if info == nil {
+		return nil
+	}
+	c := info.Components
+	return &CarPlateInfo{Type: info.Type, Components: CarPlateComponents{Prefix: c.Prefix, Region: c.Region, Suffix: c.Suffix}}
+}
@@ -0,0 +1,174 @@
+package jsonrpc
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{"positional", `{"jsonrpc":"2.0","id":1,"method":"validators.ValidateCIN","params":["12345678"]}`,
+			`{"jsonrpc":"2.0","id":1,"result":true}`},
+		{"options", `{"jsonrpc":"2.0","id":2,"method":"validators.ValidatePhoneNumber","params":["20 123 456",{"strict":true}]}`,
+			`{"jsonrpc":"2.0","id":2,"result":false}`},
+		{"named", `{"jsonrpc":"2.0","id":"a","method":"formatters.FormatCurrency","params":{"amount":1234.5,"options":{"code":true}}}`,
+			`{"jsonrpc":"2.0","id":"a","result":"1.234,500 TND"}`},
+		{"several results", `{"jsonrpc":"2.0","id":3,"method":"validators.ValidatePostalCodeWithDetails","params":["10a0"]}`,
+			`{"jsonrpc":"2.0","id":3,"result":[false,"Postal code must contain only digits"]}`},
+		{"nil error", `{"jsonrpc":"2.0","id":4,"method":"validators.CheckCIN","params":["12345678"]}`,
+			`{"jsonrpc":"2.0","id":4,"result":null}`},
+		{"struct result", `{"jsonrpc":"2.0","id":5,"method":"validators.GetCarPlateInfo","params":["RS 123 تونس",{"type":"special"}]}`,
+			`{"jsonrpc":"2.0","id":5,"result":{"type":"special","components":{"prefix":"RS 123","region":"تونس"}}}`},
+		{"lookup result", `{"jsonrpc":"2.0","id":"g","method":"validators.GetGovernorateFromPostalCode","params":["3000"]}`,
+			`{"jsonrpc":"2.0","id":"g","result":{"name":"Sfax","postalCode":"3000","region":"Center"}}`},
+		{"date", `{"jsonrpc":"2.0","id":6,"method":"formatters.FormatDateNumeric","params":["2024-01-15"]}`,
+			`{"jsonrpc":"2.0","id":6,"result":"15/01/2024"}`},
+		{"validation error", `{"jsonrpc":"2.0","id":7,"method":"validators.CheckCIN","params":["2234567"]}`,
+			`{"jsonrpc":"2.0","id":7,"error":{"code":-32000,"message":"CIN must be exactly 8 digits","data":{"code":"too_short","field":"cin","position":-1,"expected":"8 digits","message":"CIN must be exactly 8 digits"}}}`},
+		{"missing parameter", `{"jsonrpc":"2.0","id":8,"method":"validators.ValidateRIB"}`,
+			`{"jsonrpc":"2.0","id":8,"error":{"code":-32602,"message":"invalid params: missing parameter \"rib\""}}`},
+		{"unknown option", `{"jsonrpc":"2.0","id":9,"method":"validators.ValidateCIN","params":["12345678",{"strct":true}]}`,
+			`{"jsonrpc":"2.0","id":9,"error":{"code":-32602,"message":"invalid params: parameter \"options\": json: unknown field \"strct\""}}`},
+		{"too many parameters", `{"jsonrpc":"2.0","id":10,"method":"validators.Normalize","params":["a","b"]}`,
+			`{"jsonrpc":"2.0","id":10,"error":{"code":-32602,"message":"invalid params: got 2 parameters, want at most 1 ([s])"}}`},
+		{"unknown method", `{"jsonrpc":"2.0","id":11,"method":"validators.ValidateIBAN"}`,
+			`{"jsonrpc":"2.0","id":11,"error":{"code":-32601,"message":"method not found: validators.ValidateIBAN"}}`},
+		{"invalid request", `{"id":12,"method":"validators.ValidateCIN"}`,
+			`{"jsonrpc":"2.0","id":12,"error":{"code":-32600,"message":"invalid request: want \"jsonrpc\": \"2.0\" and a method"}}`},
+		{"parse error", `{"jsonrpc":`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error: unexpected end of JSON input"}}`},
+		{"notification", `{"jsonrpc":"2.0","method":"validators.ValidateCIN","params":["12345678"]}`, ``},
+		{"batch", `[{"jsonrpc":"2.0","id":1,"method":"validators.ValidateCIN","params":["12345678"]},` +
+			`{"jsonrpc":"2.0","method":"validators.ValidateCIN","params":["1"]},` +
+			`{"jsonrpc":"2.0","id":2,"method":"formatters.FormatPhoneNumber","params":["20123456"]}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":true},{"jsonrpc":"2.0","id":2,"result":"+216 20 123 456"}]`},
+		{"empty batch", `[]`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"empty batch"}}`},
+		{"ValidateBatch", `{"jsonrpc":"2.0","id":13,"method":"validators.ValidateBatch","params":[[{"cin":"12345678"},{"cin":"2"}]]}`,
+			`"summary":{"total":2,"processed":2,"valid":1,"invalid":1,"fieldErrors":{"cin":1}}`},
+	}
+
+	s := NewServer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(s.Handle(context.Background(), []byte(tt.message)))
+			if !strings.Contains(got, tt.want) || (tt.want == "") != (got == "") {
+				t.Errorf("Handle(%s)\n got: %s\nwant: %s", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServe(t *testing.T) {
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"validators.ValidateCIN","params":["12345678"]}` + "\n" +
+		"\n" +
+		`{"jsonrpc":"2.0","id":2,"method":"rpc.shutdown"}` + "\n" +
+		`{"jsonrpc":"2.0","id":3,"method":"validators.ValidateCIN","params":["12345678"]}` + "\n")
+	var out strings.Builder
+
+	if err := NewServer().Serve(context.Background(), in, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"jsonrpc":"2.0","id":1,"result":true}` + "\n" + `{"jsonrpc":"2.0","id":2,"result":null}` + "\n"
+	if out.String() != want {
+		t.Errorf("Serve() output = %q, want %q (no answer after rpc.shutdown)", out.String(), want)
+	}
+}
+
+func TestServeAfterShutdown(t *testing.T) {
+	const shutdown = `{"jsonrpc":"2.0","id":1,"method":"rpc.shutdown"}` + "\n"
+	const request = `{"jsonrpc":"2.0","id":2,"method":"validators.ValidateCIN","params":["12345678"]}` + "\n"
+
+	before := runtime.NumGoroutine()
+	s := NewServer()
+	for i := 0; i < 2; i++ {
+		var out strings.Builder
+		if err := s.Serve(context.Background(), strings.NewReader(request+shutdown+request), &out); err != nil {
+			t.Fatal(err)
+		}
+		want := `{"jsonrpc":"2.0","id":2,"result":true}` + "\n" + `{"jsonrpc":"2.0","id":1,"result":null}` + "\n"
+		if out.String() != want {
+			t.Errorf("Serve() #%d output = %q, want %q", i+1, out.String(), want)
+		}
+	}
+
+	// The readers, blocked on the unread last request, must exit
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines still running after Serve, want %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServeCancellation(t *testing.T) {
+	in, writer := io.Pipe()
+	defer writer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- NewServer().Serve(ctx, in, io.Discard)
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Serve() = %v, want nil after cancellation", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after cancellation")
+	}
+}
+
+// TestEveryFunctionIsExposed checks that every exported function of packages
+// validators and formatters is a method, unless it has no JSON form
+func TestEveryFunctionIsExposed(t *testing.T) {
+	notExposed := map[string]bool{
+		"validators.NewValidator":      true,
+		"validators.NewRegistry":       true,
+		"validators.Register":          true,
+		"validators.Lookup":            true,
+		"validators.ValidateStruct":    true,
+		"validators.AsValidationError": true,
+	}
+
+	s := NewServer()
+	for _, pkg := range []string{"validators", "formatters"} {
+		files, err := filepath.Glob(filepath.Join("..", pkg, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+					continue
+				}
+				name := pkg + "." + fn.Name.Name
+				if _, exposed := s.methods[name]; !exposed && !notExposed[name] {
+					t.Errorf("%s is not exposed", name)
+				}
+			}
+		}
+	}
+}
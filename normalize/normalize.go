@@ -0,0 +1,116 @@
+// Package normalize cleans up user input before validation.
+//
+// Values pasted from Arabic keyboards, spreadsheets or chat applications
+// often contain Arabic-Indic or Persian digits, bidirectional control marks,
+// zero-width characters and non-breaking spaces. String rewrites them to
+// their plain ASCII equivalents so that the validators can recognize them.
+//
+// Example usage:
+//
+//	normalize.String("\u200f٢٠ ١٢٣ ٤٥٦\u00a0") // "20 123 456"
+package normalize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// String normalizes s:
+//   - Arabic-Indic (٠-٩), Persian (۰-۹) and fullwidth digits become ASCII digits
+//   - bidirectional marks, zero-width characters, word joiners and soft hyphens are removed
+//   - every Unicode space (non-breaking, thin, ideographic, tabs, ...) becomes an ASCII space
+//   - Unicode dashes and the minus sign become '-', the fullwidth plus becomes '+'
+//   - leading and trailing spaces are trimmed
+//
+// Letters, including Arabic letters such as "تونس", are left untouched.
+// String does not allocate when s is already normalized.
+func String(s string) string {
+	if isNormalized(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if mapped := Rune(r); mapped >= 0 {
+			b.WriteRune(mapped)
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// Rune returns the normalized form of r, or -1 if r should be removed
+func Rune(r rune) rune {
+	switch {
+	case r < utf8.RuneSelf:
+		if r != ' ' && unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	case r >= '\u0660' && r <= '\u0669': // Arabic-Indic digits
+		return '0' + (r - '\u0660')
+	case r >= '\u06F0' && r <= '\u06F9': // Persian digits
+		return '0' + (r - '\u06F0')
+	case r >= '\uFF10' && r <= '\uFF19': // fullwidth digits
+		return '0' + (r - '\uFF10')
+	case r == '\uFF0B': // fullwidth plus
+		return '+'
+	case isInvisible(r):
+		return -1
+	case unicode.IsSpace(r):
+		return ' '
+	case (r >= '\u2010' && r <= '\u2015') || r == '\u2212' || r == '\uFE63' || r == '\uFF0D':
+		return '-'
+	}
+	return r
+}
+
+// isInvisible reports whether r is a formatting character without a visible glyph
+func isInvisible(r rune) bool {
+	switch {
+	case r >= '\u200B' && r <= '\u200F': // zero-width space/joiners, LRM, RLM
+		return true
+	case r >= '\u202A' && r <= '\u202E': // bidirectional embeddings and overrides
+		return true
+	case r >= '\u2060' && r <= '\u2064': // word joiner and invisible operators
+		return true
+	case r >= '\u2066' && r <= '\u2069': // bidirectional isolates
+		return true
+	case r == '\u061C' || r == '\uFEFF' || r == '\u00AD': // Arabic letter mark, BOM, soft hyphen
+		return true
+	}
+	return false
+}
+
+// isNormalized reports whether String would return s unchanged
+func isNormalized(s string) bool {
+	if s == "" {
+		return true
+	}
+	if s[0] == ' ' || s[len(s)-1] == ' ' {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			return isNormalizedUnicode(s[i:])
+		}
+		if c != ' ' && unicode.IsSpace(rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNormalizedUnicode is the slow path of isNormalized for strings containing non-ASCII runes
+func isNormalizedUnicode(s string) bool {
+	for _, r := range s {
+		if Rune(r) != r {
+			return false
+		}
+	}
+	return true
+}
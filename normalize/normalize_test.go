@@ -0,0 +1,47 @@
+package normalize
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Empty", "", ""},
+		{"Already normalized", "20 123 456", "20 123 456"},
+		{"Arabic-Indic digits", "١٢٣٤٥٦٧٨", "12345678"},
+		{"Persian digits", "۰۱۲۳۴۵۶۷", "01234567"},
+		{"Fullwidth digits", "２０１２３４５６", "20123456"},
+		{"Mixed digits", "٢0١2", "2012"},
+		{"Right-to-left mark", "\u200f12345678", "12345678"},
+		{"Left-to-right isolate", "\u2066+216 20 123 456\u2069", "+216 20 123 456"},
+		{"Zero-width joiner", "1234\u200d5678", "12345678"},
+		{"Byte order mark", "\ufeff1000", "1000"},
+		{"Non-breaking spaces", "20\u00a0123\u202f456", "20 123 456"},
+		{"Tab and newline", "\t1000\n", "1000"},
+		{"Inner tab", "20\t123", "20 123"},
+		{"Unicode dash", "0123–4567", "0123-4567"},
+		{"Fullwidth plus", "＋21620123456", "+21620123456"},
+		{"Arabic letters kept", "١٢٣ تونس ٤٥٦٧", "123 تونس 4567"},
+		{"Latin letters kept", "1234567a/p/m/000", "1234567a/p/m/000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := String(tt.input)
+			if result != tt.expected {
+				t.Errorf("String(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStringDoesNotAllocateWhenNormalized(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = String("123 تونس 4567")
+	})
+	if allocs != 0 {
+		t.Errorf("String allocated %v times for a normalized input, want 0", allocs)
+	}
+}
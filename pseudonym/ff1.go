@@ -0,0 +1,127 @@
+package pseudonym
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ff1 is the FF1 format-preserving encryption mode of NIST SP 800-38G for
+// strings of decimal digits. It is safe for concurrent use.
+type ff1 struct {
+	block cipher.Block
+}
+
+// FF1 parameters
+const (
+	ff1Radix  = 10
+	ff1Rounds = 10
+	// ff1MinDigits is the shortest input: the domain must have at least a million values
+	ff1MinDigits = 6
+)
+
+// encrypt encrypts the digits x, which must be at least ff1MinDigits long
+func (f *ff1) encrypt(tweak []byte, x string) string {
+	return f.feistel(tweak, x, true)
+}
+
+// decrypt reverses encrypt
+func (f *ff1) decrypt(tweak []byte, x string) string {
+	return f.feistel(tweak, x, false)
+}
+
+// feistel runs the FF1 rounds (algorithms 7 and 8 of NIST SP 800-38G)
+func (f *ff1) feistel(tweak []byte, x string, encrypt bool) string {
+	n := len(x)
+	u, v := n/2, n-n/2
+	a, b := x[:u], x[u:]
+
+	byteLen := (int(math.Ceil(float64(v)*math.Log2(ff1Radix))) + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+
+	// P and Q are MACed together: P is one block and Q is padded to a whole number of blocks
+	p := make([]byte, 16)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[5] = ff1Radix
+	p[6] = ff1Rounds
+	p[7] = byte(u)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(len(tweak)))
+
+	pad := ((-len(tweak)-byteLen-1)%16 + 16) % 16
+	q := make([]byte, len(tweak)+pad+1+byteLen)
+	copy(q, tweak)
+
+	modU := new(big.Int).Exp(big.NewInt(ff1Radix), big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(big.NewInt(ff1Radix), big.NewInt(int64(v)), nil)
+
+	for round := 0; round < ff1Rounds; round++ {
+		i, source := round, b
+		if !encrypt {
+			i, source = ff1Rounds-1-round, a
+		}
+
+		q[len(tweak)+pad] = byte(i)
+		num(source).FillBytes(q[len(q)-byteLen:])
+		y := new(big.Int).SetBytes(f.expand(f.prf(p, q), d))
+
+		m, modulus := u, modU
+		if i%2 == 1 {
+			m, modulus = v, modV
+		}
+
+		if encrypt {
+			c := y.Add(y, num(a)).Mod(y, modulus)
+			a, b = b, digits(c, m)
+		} else {
+			c := y.Sub(num(b), y).Mod(y, modulus)
+			a, b = digits(c, m), a
+		}
+	}
+	return a + b
+}
+
+// prf is the CBC-MAC of p and q with a zero IV
+func (f *ff1) prf(p, q []byte) []byte {
+	r := make([]byte, 16)
+	for _, data := range [][]byte{p, q} {
+		for j := 0; j < len(data); j += 16 {
+			for k := range r {
+				r[k] ^= data[j+k]
+			}
+			f.block.Encrypt(r, r)
+		}
+	}
+	return r
+}
+
+// expand extends the block r to d bytes: r, then the encryptions of r XOR 1, r XOR 2, ...
+func (f *ff1) expand(r []byte, d int) []byte {
+	s := append([]byte(nil), r...)
+	block := make([]byte, 16)
+	for j := uint64(1); len(s) < d; j++ {
+		copy(block, r)
+		var counter [8]byte
+		binary.BigEndian.PutUint64(counter[:], j)
+		for k := range counter {
+			block[8+k] ^= counter[k]
+		}
+		f.block.Encrypt(block, block)
+		s = append(s, block...)
+	}
+	return s[:d]
+}
+
+// num returns the value of a string of decimal digits
+func num(s string) *big.Int {
+	n, _ := new(big.Int).SetString(s, ff1Radix)
+	return n
+}
+
+// digits returns n as m decimal digits, with leading zeros
+func digits(n *big.Int, m int) string {
+	s := n.Text(ff1Radix)
+	return strings.Repeat("0", m-len(s)) + s
+}
@@ -0,0 +1,370 @@
+// Package pseudonym replaces Tunisian identifiers with pseudonyms that are
+// still valid identifiers, so that analytics exports and test databases keep
+// passing ValidateCIN, ValidatePhoneNumber and ValidateRIB.
+//
+// A Cipher encrypts identifiers with FF1, the format-preserving encryption
+// mode of NIST SP 800-38G, and decrypts them with the same key. A Tokenizer
+// derives them with HMAC-SHA256: the same identifier always gives the same
+// pseudonym, which cannot be reversed, and two identifiers may occasionally
+// give the same one.
+//
+// Structural parts of the identifiers are encrypted too, within their valid
+// values: a CIN starts with 0 or 1, a phone number with a carrier prefix and a
+// RIB with a known bank code. Options can leave the carrier prefix or the
+// bank code in clear for statistics per carrier or bank. RIB keys are
+// recomputed, so a RIB with a correct key keeps a correct key.
+//
+// Example usage:
+//
+//	c, err := pseudonym.NewCipher(key) // a 16, 24 or 32-byte AES key
+//	encrypted, err := c.EncryptCIN("12345678")
+//	original, err := c.DecryptCIN(encrypted) // "12345678"
+//
+//	t, err := pseudonym.NewTokenizer(key, pseudonym.Options{KeepBankCode: true})
+//	pseudonymous, err := t.RIB("01234567890123456789") // "01..." with a correct key
+package pseudonym
+
+import (
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/types"
+)
+
+// ErrKeySize is returned when a key is not 16, 24 or 32 bytes long
+var ErrKeySize = errors.New("key must be 16, 24 or 32 bytes")
+
+// Options configures a Cipher or a Tokenizer
+type Options struct {
+	// KeepCarrierPrefix leaves the first digit of phone numbers, which identifies the carrier, in clear
+	KeepCarrierPrefix bool
+	// KeepBankCode leaves the bank code of RIBs in clear
+	KeepBankCode bool
+	// Tweak separates domains: the same key with different tweaks (e.g. one per
+	// export) gives unrelated pseudonyms. It must be the same to decrypt.
+	Tweak []byte
+}
+
+// domain describes the valid values of an identifier: a head among heads
+// followed by width digits
+type domain struct {
+	kind  string
+	heads []string
+	width int
+}
+
+// Domains of the identifiers. The 2 digits of a RIB key are handled separately.
+var (
+	cinDomain   = domain{kind: "cin", heads: []string{"0", "1"}, width: 7}
+	phoneDomain = domain{kind: "phone", heads: sorted(constants.ValidPrefixes), width: 7}
+	ribDomain   = domain{kind: "rib", heads: sorted(bankCodes()), width: 16}
+)
+
+// sorted returns a sorted copy of s
+func sorted(s []string) []string {
+	s = append([]string(nil), s...)
+	sort.Strings(s)
+	return s
+}
+
+// bankCodes returns the known bank codes
+func bankCodes() []string {
+	codes := make([]string, 0, len(constants.Banks))
+	for code := range constants.Banks {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// split returns the index of the head of value and the digits after it
+func (d domain) split(value string) (int, string) {
+	head := value[:len(value)-d.width]
+	return sort.SearchStrings(d.heads, head), value[len(head):]
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// size returns the number of values of the domain, or of its digits only if the head is kept
+func (d domain) size(keepHead bool) *big.Int {
+	if keepHead {
+		return pow10(d.width)
+	}
+	return new(big.Int).Mul(big.NewInt(int64(len(d.heads))), pow10(d.width))
+}
+
+// encode numbers the value of the domain, or its digits only if the head is kept
+func (d domain) encode(value string, keepHead bool) *big.Int {
+	head, rest := d.split(value)
+	n := num(rest)
+	if !keepHead {
+		n.Add(n, new(big.Int).Mul(big.NewInt(int64(head)), pow10(d.width)))
+	}
+	return n
+}
+
+// decode reverses encode; head is the clear head when it is kept
+func (d domain) decode(n *big.Int, keepHead bool, head string) string {
+	if keepHead {
+		return head + digits(n, d.width)
+	}
+	index, rest := new(big.Int).DivMod(n, pow10(d.width), new(big.Int))
+	return d.heads[index.Int64()] + digits(rest, d.width)
+}
+
+// tweak returns the tweak of a value: the kind, the clear head if it is kept and the user tweak
+func (d domain) tweak(value string, keepHead bool, user []byte) []byte {
+	head := ""
+	if keepHead {
+		head = value[:len(value)-d.width]
+	}
+	return append([]byte(d.kind+"/"+head+"/"), user...)
+}
+
+// ribKey computes the key of ValidateRIBChecksum from the first 18 digits of a RIB
+func ribKey(rib string) int {
+	n, _ := strconv.ParseInt(rib[:18], 10, 64)
+	return int(n % 97)
+}
+
+// Cipher encrypts identifiers with FF1. It is safe for concurrent use.
+type Cipher struct {
+	ff1  ff1
+	opts Options
+}
+
+// NewCipher creates a Cipher
+//
+// Parameters:
+//   - key: The AES key, 16, 24 or 32 bytes long
+//   - opts: Structural parts to keep in clear and tweak (optional)
+//
+// Returns:
+//   - *Cipher: the cipher
+//   - error: ErrKeySize if the key has another length
+func NewCipher(key []byte, opts ...Options) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrKeySize
+	}
+
+	c := &Cipher{ff1: ff1{block: block}}
+	if len(opts) > 0 {
+		c.opts = opts[0]
+	}
+	return c, nil
+}
+
+// crypt encrypts or decrypts a valid value of the domain. Values of the
+// domain are numbered and encrypted as strings of digits; when the domain is
+// smaller than the set of strings (e.g. 2×10^7 CINs among 10^8 strings of 8
+// digits), the result is encrypted again until it is in the domain (cycle
+// walking), which keeps the encryption a permutation of the domain.
+func (c *Cipher) crypt(d domain, value string, keepHead, encrypt bool) string {
+	size := d.size(keepHead)
+	length := len(new(big.Int).Sub(size, big.NewInt(1)).Text(10))
+	tweak := d.tweak(value, keepHead, c.opts.Tweak)
+
+	x := digits(d.encode(value, keepHead), length)
+	for {
+		if encrypt {
+			x = c.ff1.encrypt(tweak, x)
+		} else {
+			x = c.ff1.decrypt(tweak, x)
+		}
+		if n := num(x); n.Cmp(size) < 0 {
+			return d.decode(n, keepHead, value[:len(value)-d.width])
+		}
+	}
+}
+
+// EncryptCIN encrypts a CIN into another valid CIN
+//
+// Parameters:
+//   - cin: The CIN to encrypt, normalized like ValidateCIN does
+//
+// Returns:
+//   - string: the encrypted CIN
+//   - error: the validation error if the CIN is invalid
+//
+// Example:
+//
+//	encrypted, err := c.EncryptCIN("12345678")
+func (c *Cipher) EncryptCIN(cin string) (string, error) {
+	parsed, err := types.ParseCIN(cin)
+	if err != nil {
+		return "", err
+	}
+	return c.crypt(cinDomain, string(parsed), false, true), nil
+}
+
+// DecryptCIN decrypts a CIN encrypted by EncryptCIN with the same key and options
+func (c *Cipher) DecryptCIN(cin string) (string, error) {
+	parsed, err := types.ParseCIN(cin)
+	if err != nil {
+		return "", err
+	}
+	return c.crypt(cinDomain, string(parsed), false, false), nil
+}
+
+// EncryptPhoneNumber encrypts a phone number into another valid phone number,
+// keeping its carrier prefix if Options.KeepCarrierPrefix is set
+//
+// Parameters:
+//   - phoneNumber: The phone number to encrypt, in any format accepted by ValidatePhoneNumber
+//
+// Returns:
+//   - string: the encrypted number, as 8 national digits
+//   - error: the validation error if the number is invalid
+func (c *Cipher) EncryptPhoneNumber(phoneNumber string) (string, error) {
+	parsed, err := types.ParsePhoneNumber(phoneNumber)
+	if err != nil {
+		return "", err
+	}
+	return c.crypt(phoneDomain, string(parsed), c.opts.KeepCarrierPrefix, true), nil
+}
+
+// DecryptPhoneNumber decrypts a phone number encrypted by EncryptPhoneNumber with the same key and options
+func (c *Cipher) DecryptPhoneNumber(phoneNumber string) (string, error) {
+	parsed, err := types.ParsePhoneNumber(phoneNumber)
+	if err != nil {
+		return "", err
+	}
+	return c.crypt(phoneDomain, string(parsed), c.opts.KeepCarrierPrefix, false), nil
+}
+
+// EncryptRIB encrypts a RIB into another valid RIB, keeping its bank code if
+// Options.KeepBankCode is set. The key is shifted by the difference between
+// the expected keys, so a correct key stays correct and decryption restores
+// the original key, even a wrong one.
+//
+// Parameters:
+//   - rib: The RIB to encrypt
+//
+// Returns:
+//   - string: the encrypted RIB
+//   - error: the validation error if the RIB is invalid
+func (c *Cipher) EncryptRIB(rib string) (string, error) {
+	return c.cryptRIB(rib, true)
+}
+
+// DecryptRIB decrypts a RIB encrypted by EncryptRIB with the same key and options
+func (c *Cipher) DecryptRIB(rib string) (string, error) {
+	return c.cryptRIB(rib, false)
+}
+
+// cryptRIB encrypts or decrypts the first 18 digits of a RIB and shifts its key
+func (c *Cipher) cryptRIB(rib string, encrypt bool) (string, error) {
+	parsed, err := types.ParseRIB(rib)
+	if err != nil {
+		return "", err
+	}
+
+	in := string(parsed[:18])
+	out := c.crypt(ribDomain, in, c.opts.KeepBankCode, encrypt)
+	key, _ := strconv.Atoi(string(parsed[18:]))
+	key = ((key-ribKey(in)+ribKey(out))%100 + 100) % 100
+	return fmt.Sprintf("%s%02d", out, key), nil
+}
+
+// Tokenizer derives pseudonyms with HMAC-SHA256. It is safe for concurrent use.
+type Tokenizer struct {
+	key  []byte
+	opts Options
+}
+
+// NewTokenizer creates a Tokenizer
+//
+// Parameters:
+//   - key: The HMAC key, 16, 24 or 32 bytes long
+//   - opts: Structural parts to keep in clear and tweak (optional)
+//
+// Returns:
+//   - *Tokenizer: the tokenizer
+//   - error: ErrKeySize if the key has another length
+func NewTokenizer(key []byte, opts ...Options) (*Tokenizer, error) {
+	if n := len(key); n != 16 && n != 24 && n != 32 {
+		return nil, ErrKeySize
+	}
+
+	t := &Tokenizer{key: append([]byte(nil), key...)}
+	if len(opts) > 0 {
+		t.opts = opts[0]
+	}
+	return t, nil
+}
+
+// derive maps the HMAC of a valid value of the domain to a value of the domain
+func (t *Tokenizer) derive(d domain, value string, keepHead bool) string {
+	mac := hmac.New(sha256.New, t.key)
+	mac.Write(d.tweak(value, keepHead, t.opts.Tweak))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+
+	n := new(big.Int).SetBytes(mac.Sum(nil))
+	return d.decode(n.Mod(n, d.size(keepHead)), keepHead, value[:len(value)-d.width])
+}
+
+// CIN returns the pseudonym of a CIN, a valid CIN
+//
+// Parameters:
+//   - cin: The CIN, normalized like ValidateCIN does
+//
+// Returns:
+//   - string: the pseudonym
+//   - error: the validation error if the CIN is invalid
+//
+// Example:
+//
+//	pseudonymous, err := t.CIN("12345678")
+func (t *Tokenizer) CIN(cin string) (string, error) {
+	parsed, err := types.ParseCIN(cin)
+	if err != nil {
+		return "", err
+	}
+	return t.derive(cinDomain, string(parsed), false), nil
+}
+
+// PhoneNumber returns the pseudonym of a phone number, a valid phone number
+// as 8 national digits with the same carrier prefix if Options.KeepCarrierPrefix is set
+//
+// Parameters:
+//   - phoneNumber: The phone number, in any format accepted by ValidatePhoneNumber
+//
+// Returns:
+//   - string: the pseudonym
+//   - error: the validation error if the number is invalid
+func (t *Tokenizer) PhoneNumber(phoneNumber string) (string, error) {
+	parsed, err := types.ParsePhoneNumber(phoneNumber)
+	if err != nil {
+		return "", err
+	}
+	return t.derive(phoneDomain, string(parsed), t.opts.KeepCarrierPrefix), nil
+}
+
+// RIB returns the pseudonym of a RIB, a valid RIB with a correct key and the
+// same bank code if Options.KeepBankCode is set
+//
+// Parameters:
+//   - rib: The RIB
+//
+// Returns:
+//   - string: the pseudonym
+//   - error: the validation error if the RIB is invalid
+func (t *Tokenizer) RIB(rib string) (string, error) {
+	parsed, err := types.ParseRIB(rib)
+	if err != nil {
+		return "", err
+	}
+	out := t.derive(ribDomain, string(parsed[:18]), t.opts.KeepBankCode)
+	return fmt.Sprintf("%s%02d", out, ribKey(out)), nil
+}
@@ -0,0 +1,174 @@
+package pseudonym
+
+import (
+	"crypto/aes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/fake"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// TestFF1 checks the radix-10 samples of NIST SP 800-38G
+func TestFF1(t *testing.T) {
+	tests := []struct {
+		name, key, tweak, plaintext, ciphertext string
+	}{
+		{"AES-128", "2B7E151628AED2A6ABF7158809CF4F3C", "", "0123456789", "2433477484"},
+		{"AES-128 tweak", "2B7E151628AED2A6ABF7158809CF4F3C", "39383736353433323130", "0123456789", "6124200773"},
+		{"AES-192", "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F", "", "0123456789", "2830668132"},
+		{"AES-192 tweak", "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F", "39383736353433323130", "0123456789", "2496655549"},
+		{"AES-256", "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94", "", "0123456789", "6657667009"},
+		{"AES-256 tweak", "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94", "39383736353433323130", "0123456789", "1001623463"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, _ := hex.DecodeString(tt.key)
+			tweak, _ := hex.DecodeString(tt.tweak)
+			block, err := aes.NewCipher(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := ff1{block: block}
+
+			if got := f.encrypt(tweak, tt.plaintext); got != tt.ciphertext {
+				t.Errorf("encrypt(%s) = %s, want %s", tt.plaintext, got, tt.ciphertext)
+			}
+			if got := f.decrypt(tweak, tt.ciphertext); got != tt.plaintext {
+				t.Errorf("decrypt(%s) = %s, want %s", tt.ciphertext, got, tt.plaintext)
+			}
+		})
+	}
+}
+
+var testKey = []byte("0123456789abcdef")
+
+func TestCipher(t *testing.T) {
+	for _, opts := range []Options{{}, {KeepCarrierPrefix: true, KeepBankCode: true}, {Tweak: []byte("export-2024")}} {
+		c, err := NewCipher(testKey, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		g := fake.New(1)
+		for i := 0; i < 200; i++ {
+			cin, phone, rib := g.CIN(), g.PhoneNumber(), g.RIB()
+
+			encrypted, err := c.EncryptCIN(cin)
+			if err != nil || !validators.ValidateCIN(encrypted) {
+				t.Fatalf("EncryptCIN(%s) = %q, %v, want a valid CIN", cin, encrypted, err)
+			}
+			if decrypted, _ := c.DecryptCIN(encrypted); decrypted != cin {
+				t.Errorf("DecryptCIN(EncryptCIN(%s)) = %s", cin, decrypted)
+			}
+
+			encrypted, err = c.EncryptPhoneNumber("+216 " + phone)
+			if err != nil || !validators.ValidatePhoneNumber(encrypted) {
+				t.Fatalf("EncryptPhoneNumber(%s) = %q, %v, want a valid phone number", phone, encrypted, err)
+			}
+			if opts.KeepCarrierPrefix && encrypted[0] != phone[0] {
+				t.Errorf("EncryptPhoneNumber(%s) = %s, want the carrier prefix kept", phone, encrypted)
+			}
+			if decrypted, _ := c.DecryptPhoneNumber(encrypted); decrypted != phone {
+				t.Errorf("DecryptPhoneNumber(EncryptPhoneNumber(%s)) = %s", phone, decrypted)
+			}
+
+			encrypted, err = c.EncryptRIB(rib)
+			if err != nil || !validators.ValidateRIBChecksum(encrypted) {
+				t.Fatalf("EncryptRIB(%s) = %q, %v, want a valid RIB with a correct key", rib, encrypted, err)
+			}
+			if opts.KeepBankCode && encrypted[:2] != rib[:2] {
+				t.Errorf("EncryptRIB(%s) = %s, want the bank code kept", rib, encrypted)
+			}
+			if decrypted, _ := c.DecryptRIB(encrypted); decrypted != rib {
+				t.Errorf("DecryptRIB(EncryptRIB(%s)) = %s", rib, decrypted)
+			}
+		}
+	}
+}
+
+func TestCipherRIBWithWrongKey(t *testing.T) {
+	c, _ := NewCipher(testKey)
+	const rib = "01234567890123456789" // the key should be 17
+
+	encrypted, err := c.EncryptRIB(rib)
+	if err != nil || !validators.ValidateRIB(encrypted) {
+		t.Fatalf("EncryptRIB(%s) = %q, %v, want a valid RIB", rib, encrypted, err)
+	}
+	if decrypted, _ := c.DecryptRIB(encrypted); decrypted != rib {
+		t.Errorf("DecryptRIB(EncryptRIB(%s)) = %s", rib, decrypted)
+	}
+}
+
+func TestCipherTweak(t *testing.T) {
+	a, _ := NewCipher(testKey, Options{Tweak: []byte("a")})
+	b, _ := NewCipher(testKey, Options{Tweak: []byte("b")})
+
+	differences := 0
+	g := fake.New(2)
+	for i := 0; i < 20; i++ {
+		cin := g.CIN()
+		x, _ := a.EncryptCIN(cin)
+		y, _ := b.EncryptCIN(cin)
+		if x != y {
+			differences++
+		}
+	}
+	if differences < 15 {
+		t.Errorf("different tweaks gave the same pseudonym for %d of 20 CINs", 20-differences)
+	}
+}
+
+func TestTokenizer(t *testing.T) {
+	plain, _ := NewTokenizer(testKey)
+	keep, _ := NewTokenizer(testKey, Options{KeepCarrierPrefix: true, KeepBankCode: true})
+
+	g := fake.New(3)
+	seen := map[string]bool{}
+	for i := 0; i < 200; i++ {
+		cin, phone, rib := g.CIN(), g.PhoneNumber(), g.RIB()
+
+		pseudonymous, err := plain.CIN(cin)
+		if err != nil || !validators.ValidateCIN(pseudonymous) {
+			t.Fatalf("CIN(%s) = %q, %v, want a valid CIN", cin, pseudonymous, err)
+		}
+		if again, _ := plain.CIN(cin); again != pseudonymous {
+			t.Errorf("CIN(%s) = %s then %s, want the same pseudonym", cin, pseudonymous, again)
+		}
+		seen[pseudonymous] = true
+
+		pseudonymous, err = keep.PhoneNumber(phone)
+		if err != nil || !validators.ValidatePhoneNumber(pseudonymous) || pseudonymous[0] != phone[0] {
+			t.Fatalf("PhoneNumber(%s) = %q, %v, want a valid number with the same prefix", phone, pseudonymous, err)
+		}
+
+		pseudonymous, err = keep.RIB(rib)
+		if err != nil || !validators.ValidateRIBChecksum(pseudonymous) || pseudonymous[:2] != rib[:2] {
+			t.Fatalf("RIB(%s) = %q, %v, want a valid RIB of the same bank", rib, pseudonymous, err)
+		}
+	}
+	if len(seen) < 190 {
+		t.Errorf("200 CINs gave %d distinct pseudonyms", len(seen))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := NewCipher([]byte("short")); !errors.Is(err, ErrKeySize) {
+		t.Errorf("NewCipher(short key) error = %v, want ErrKeySize", err)
+	}
+	if _, err := NewTokenizer(make([]byte, 8)); !errors.Is(err, ErrKeySize) {
+		t.Errorf("NewTokenizer(short key) error = %v, want ErrKeySize", err)
+	}
+
+	c, _ := NewCipher(testKey)
+	if _, err := c.EncryptCIN("22345678"); !errors.Is(err, types.ErrBadPrefix) {
+		t.Errorf("EncryptCIN(invalid) error = %v, want ErrBadPrefix", err)
+	}
+	tk, _ := NewTokenizer(testKey)
+	if _, err := tk.RIB("99234567890123456789"); !errors.Is(err, types.ErrUnknownBank) {
+		t.Errorf("RIB(unknown bank) error = %v, want ErrUnknownBank", err)
+	}
+}
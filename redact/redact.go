@@ -0,0 +1,214 @@
+// Package redact finds Tunisian identifiers in free text and rewrites them.
+//
+// Support tickets, e-mails and chat transcripts mention CINs, phone numbers,
+// RIBs and Tax IDs in French and Arabic prose, written with Arabic-Indic
+// digits, grouped digits ("20 123 456", "01 234 5678901234567 89") or an
+// international prefix ("+216 20 123 456"). Scan finds them, with their byte
+// offsets in the text and a confidence score, and checks every candidate with
+// the validators to discard numbers that only look like identifiers. Redact
+// rewrites the text, replacing each identifier with a placeholder or a masked
+// value.
+//
+// Example usage:
+//
+//	redact.Redact("CIN ١٢٣٤٥٦٧٨, tél: 20 123 456")
+//	// "CIN [CIN], tél: [PHONE]"
+//
+//	redact.Redact("tél: 20 123 456", redact.Options{Replace: redact.Mask})
+//	// "tél: +216 20 *** 456"
+package redact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/formatters"
+	"github.com/degache-go/degache/types"
+)
+
+// Kinds of identifiers, named after the validators checking them
+const (
+	KindCIN   = "cin"
+	KindPhone = "phone"
+	KindRIB   = "rib"
+	KindTaxID = "taxID"
+)
+
+// ErrUnknownKind is returned by Options.Validate for a kind that Scan cannot find
+var ErrUnknownKind = errors.New("unknown kind of identifier")
+
+// Match is an identifier found in a text
+type Match struct {
+	// Kind is the kind of identifier (KindCIN, KindPhone, KindRIB or KindTaxID)
+	Kind string `json:"kind"`
+	// Start and End are the byte offsets of the identifier in the text: text[Start:End] is Text
+	Start int `json:"start"`
+	End   int `json:"end"`
+	// Text is the identifier as written, including separators and a "+216" prefix
+	Text string `json:"text"`
+	// Value is the canonical form of the identifier (E.164 for phone numbers)
+	Value string `json:"value"`
+	// Valid reports whether the validator accepts Value
+	Valid bool `json:"valid"`
+	// Code is the validation error code of an invalid identifier
+	Code types.ErrorCode `json:"code,omitempty"`
+	// Confidence estimates the probability, between 0 and 1, that the match is an identifier of its kind
+	Confidence float64 `json:"confidence"`
+}
+
+// Options configures Scan and Redact
+type Options struct {
+	// Kinds restricts the search to some kinds of identifiers (all kinds if
+	// empty). Names are matched case-insensitively; if one of them is unknown
+	// (see Validate), every kind is searched so that a typo cannot leave
+	// identifiers unredacted.
+	Kinds []string `json:"kinds,omitempty"`
+	// MinConfidence discards matches with a lower confidence
+	MinConfidence float64 `json:"minConfidence,omitempty"`
+	// IncludeInvalid also reports numbers laid out like an identifier but rejected by its validator
+	IncludeInvalid bool `json:"includeInvalid,omitempty"`
+	// Replace returns the replacement text of a match in Redact (Placeholder by default)
+	Replace func(m Match) string `json:"-"`
+}
+
+// kinds lists the kinds of identifiers in a stable order
+var kinds = []string{KindCIN, KindPhone, KindRIB, KindTaxID}
+
+// Kinds returns the kinds of identifiers Scan can find
+//
+// Returns:
+//   - []string: cin, phone, rib and taxID
+func Kinds() []string {
+	return append([]string(nil), kinds...)
+}
+
+// Validate checks that every name in Kinds is a kind of identifier
+//
+// Returns:
+//   - error: an error wrapping ErrUnknownKind for the first unknown name, nil otherwise
+//
+// Example:
+//
+//	err := redact.Options{Kinds: []string{"phones"}}.Validate() // unknown kind of identifier: "phones"
+func (o Options) Validate() error {
+	for _, kind := range o.Kinds {
+		if knownKind(kind) == "" {
+			return fmt.Errorf("%w: %q", ErrUnknownKind, kind)
+		}
+	}
+	return nil
+}
+
+// knownKind returns the kind named name, case-insensitively, or an empty string
+func knownKind(name string) string {
+	for _, kind := range kinds {
+		if strings.EqualFold(name, kind) {
+			return kind
+		}
+	}
+	return ""
+}
+
+// placeholders are the replacements written by Placeholder
+var placeholders = map[string]string{
+	KindCIN:   "[CIN]",
+	KindPhone: "[PHONE]",
+	KindRIB:   "[RIB]",
+	KindTaxID: "[TAX_ID]",
+}
+
+// Placeholder replaces an identifier with its kind in brackets, e.g. "[CIN]" or "[TAX_ID]"
+func Placeholder(m Match) string {
+	return placeholders[m.Kind]
+}
+
+// Mask replaces an identifier with its masked form, as written by the
+// formatters.Mask* functions with the default policies of package types:
+// "12****78", "+216 20 *** 456", "01****************89", "****567A/P/M/000".
+// An invalid identifier is masked entirely.
+func Mask(m Match) string {
+	switch m.Kind {
+	case KindCIN:
+		return formatters.MaskCIN(m.Value)
+	case KindPhone:
+		return formatters.MaskPhoneNumber(m.Value)
+	case KindRIB:
+		return formatters.MaskRIB(m.Value)
+	case KindTaxID:
+		return formatters.MaskTaxID(m.Value)
+	}
+	return strings.Repeat("*", len(m.Value))
+}
+
+// Scan finds the identifiers in text
+//
+// Parameters:
+//   - text: The text to search, in any language
+//   - opts: Kinds to find, minimum confidence and whether to report invalid identifiers (optional)
+//
+// Returns:
+//   - []Match: the identifiers, in the order of their position in the text
+//
+// Example:
+//
+//	for _, m := range redact.Scan("Mon RIB: 01 234 5678901234567 89") {
+//	    fmt.Println(m.Kind, m.Start, m.End, m.Value) // rib 9 32 01234567890123456789
+//	}
+func Scan(text string, opts ...Options) []Match {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	// An unknown kind fails closed: every kind is searched
+	all := len(o.Kinds) == 0 || o.Validate() != nil
+	enabled := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		enabled[kind] = all
+	}
+	for _, kind := range o.Kinds {
+		if known := knownKind(kind); known != "" {
+			enabled[known] = true
+		}
+	}
+
+	var matches []Match
+	for _, m := range newScanner(text).candidates(enabled, o.IncludeInvalid) {
+		m.Confidence = confidence(text, m)
+		if m.Confidence >= o.MinConfidence {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// Redact replaces the identifiers found by Scan in text
+//
+// Parameters:
+//   - text: The text to redact
+//   - opts: The Scan options and the replacement function (optional, Placeholder by default)
+//
+// Returns:
+//   - string: the text with every identifier replaced
+//
+// Example:
+//
+//	Redact("Appelez-moi au +216 98 123 456") // "Appelez-moi au [PHONE]"
+func Redact(text string, opts ...Options) string {
+	replace := Placeholder
+	if len(opts) > 0 && opts[0].Replace != nil {
+		replace = opts[0].Replace
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	last := 0
+	for _, m := range Scan(text, opts...) {
+		b.WriteString(text[last:m.Start])
+		b.WriteString(replace(m))
+		last = m.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
@@ -0,0 +1,151 @@
+package redact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/fake"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		opts Options
+		want []Match
+	}{
+		{"CIN", "CIN: 12345678", Options{},
+			[]Match{{Kind: KindCIN, Start: 5, End: 13, Text: "12345678", Value: "12345678", Valid: true, Confidence: 0.9}}},
+		{"Arabic-Indic CIN with a bidirectional mark", "رقم بطاقة التعريف: ‏٠٩٨٧٦٥٤٣", Options{},
+			[]Match{{Kind: KindCIN, Start: 37, End: 53, Text: "٠٩٨٧٦٥٤٣", Value: "09876543", Valid: true, Confidence: 0.9}}},
+		{"grouped phone", "appelez le 20 123 456", Options{},
+			[]Match{{Kind: KindPhone, Start: 11, End: 21, Text: "20 123 456", Value: "+21620123456", Valid: true, Confidence: 1}}},
+		{"international phone", "+216 98.12.34.56", Options{},
+			[]Match{{Kind: KindPhone, Start: 0, End: 16, Text: "+216 98.12.34.56", Value: "+21698123456", Valid: true, Confidence: 0.9}}},
+		{"phone with 00 prefix", "0021620123456", Options{},
+			[]Match{{Kind: KindPhone, Start: 0, End: 13, Text: "0021620123456", Value: "+21620123456", Valid: true, Confidence: 0.9}}},
+		{"grouped RIB", "Mon RIB: 01 234 5678901234567 89", Options{},
+			[]Match{{Kind: KindRIB, Start: 9, End: 32, Text: "01 234 5678901234567 89", Value: "01234567890123456789", Valid: true, Confidence: 1}}},
+		{"RIB in an IBAN", "TN59 0123 4567 8901 2345 6789", Options{},
+			[]Match{{Kind: KindRIB, Start: 5, End: 29, Text: "0123 4567 8901 2345 6789", Value: "01234567890123456789", Valid: true, Confidence: 0.8}}},
+		{"lower-case Tax ID", "MF 1234567a/p/m/000.", Options{},
+			[]Match{{Kind: KindTaxID, Start: 3, End: 19, Text: "1234567a/p/m/000", Value: "1234567A/P/M/000", Valid: true, Confidence: 1}}},
+		{"adjacent identifiers", "12345678 20123456", Options{}, []Match{
+			{Kind: KindCIN, Start: 0, End: 8, Text: "12345678", Value: "12345678", Valid: true, Confidence: 0.6},
+			{Kind: KindPhone, Start: 9, End: 17, Text: "20123456", Value: "+21620123456", Valid: true, Confidence: 0.7}}},
+		{"date and amount", "le 15.01.2024, 1.234,500 DT", Options{}, nil},
+		{"foreign phone", "+33 12345678", Options{}, nil},
+		{"inside a word", "ref ABC12345678 or 12345678XYZ", Options{}, nil},
+		{"too many digits", "123456789012", Options{}, nil},
+		{"prefix of a longer number", "ref 20 123 456 789", Options{}, nil},
+		{"prefix of a longer invalid number", "ref 20 123 456 789", Options{IncludeInvalid: true}, nil},
+		{"after a date", "le 15.01.2024 12345678", Options{},
+			[]Match{{Kind: KindCIN, Start: 14, End: 22, Text: "12345678", Value: "12345678", Valid: true, Confidence: 0.6}}},
+		{"invalid not reported", "fixe 71 123 456", Options{}, nil},
+		{"invalid reported", "fixe 71 123 456", Options{IncludeInvalid: true},
+			[]Match{{Kind: KindPhone, Start: 5, End: 15, Text: "71 123 456", Value: "+21671123456", Code: "bad_prefix", Confidence: 0.2}}},
+		{"kinds", "12345678 20123456", Options{Kinds: []string{"PHONE"}},
+			[]Match{{Kind: KindPhone, Start: 9, End: 17, Text: "20123456", Value: "+21620123456", Valid: true, Confidence: 0.7}}},
+		{"unknown kind fails closed", "12345678 20123456", Options{Kinds: []string{KindRIB, "phones"}}, []Match{
+			{Kind: KindCIN, Start: 0, End: 8, Text: "12345678", Value: "12345678", Valid: true, Confidence: 0.6},
+			{Kind: KindPhone, Start: 9, End: 17, Text: "20123456", Value: "+21620123456", Valid: true, Confidence: 0.7}}},
+		{"keyword inside a word", "hôtel 20 123 456", Options{},
+			[]Match{{Kind: KindPhone, Start: 7, End: 17, Text: "20 123 456", Value: "+21620123456", Valid: true, Confidence: 0.7}}},
+		{"word cut by the context", "xxmf" + strings.Repeat(" ", 38) + "1234567A/P/M/000", Options{},
+			[]Match{{Kind: KindTaxID, Start: 42, End: 58, Text: "1234567A/P/M/000", Value: "1234567A/P/M/000", Valid: true, Confidence: 0.95}}},
+		{"CIN followed by a number", "CIN 12345678 3 fois", Options{},
+			[]Match{{Kind: KindCIN, Start: 4, End: 12, Text: "12345678", Value: "12345678", Valid: true, Confidence: 0.9}}},
+		{"phone followed by a number", "appelez le 20123456 24h/24", Options{},
+			[]Match{{Kind: KindPhone, Start: 11, End: 19, Text: "20123456", Value: "+21620123456", Valid: true, Confidence: 1}}},
+		{"grouped phone followed by a number", "tél 20 123 456 10 fois", Options{},
+			[]Match{{Kind: KindPhone, Start: 5, End: 15, Text: "20 123 456", Value: "+21620123456", Valid: true, Confidence: 1}}},
+		{"RIB followed by a number", "RIB 01234567890123456789 2 comptes", Options{},
+			[]Match{{Kind: KindRIB, Start: 4, End: 24, Text: "01234567890123456789", Value: "01234567890123456789", Valid: true, Confidence: 1}}},
+		{"minimum confidence", "12345678 tél 20123456", Options{MinConfidence: 0.8},
+			[]Match{{Kind: KindPhone, Start: 14, End: 22, Text: "20123456", Value: "+21620123456", Valid: true, Confidence: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Scan(tt.text, tt.opts)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Scan(%q)\n got: %+v\nwant: %+v", tt.text, got, tt.want)
+			}
+			for _, m := range got {
+				if tt.text[m.Start:m.End] != m.Text {
+					t.Errorf("text[%d:%d] = %q, want %q", m.Start, m.End, tt.text[m.Start:m.End], m.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	text := "CIN ١٢٣٤٥٦٧٨, tél: 20 123 456, RIB 01234567890123456789, MF 1234567A/P/M/000"
+
+	tests := []struct {
+		name string
+		opts []Options
+		want string
+	}{
+		{"placeholder", nil, "CIN [CIN], tél: [PHONE], RIB [RIB], MF [TAX_ID]"},
+		{"mask", []Options{{Replace: Mask}},
+			"CIN 12****78, tél: +216 20 *** 456, RIB 01****************89, MF ****567A/P/M/000"},
+		{"custom", []Options{{Kinds: []string{KindRIB}, Replace: func(m Match) string { return "<" + m.Kind + ">" }}},
+			"CIN ١٢٣٤٥٦٧٨, tél: 20 123 456, RIB <rib>, MF 1234567A/P/M/000"},
+		{"unknown kind", []Options{{Kinds: []string{"phones"}}}, "CIN [CIN], tél: [PHONE], RIB [RIB], MF [TAX_ID]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(text, tt.opts...); got != tt.want {
+				t.Errorf("Redact() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Numbers following an identifier in prose must not hide it
+	prose := map[string]string{
+		"CIN 12345678 3 fois":                "CIN [CIN] 3 fois",
+		"appelez le 20123456 24h/24":         "appelez le [PHONE] 24h/24",
+		"tél 20 123 456 10 fois":             "tél [PHONE] 10 fois",
+		"RIB 01234567890123456789 2 comptes": "RIB [RIB] 2 comptes",
+		"ref 20 123 456 789":                 "ref 20 123 456 789",
+	}
+	for text, want := range prose {
+		if got := Redact(text); got != want {
+			t.Errorf("Redact(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestOptionsValidate(t *testing.T) {
+	if err := (Options{Kinds: []string{"CIN", KindTaxID}}).Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if err := (Options{Kinds: []string{KindCIN, "phones"}}).Validate(); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("Validate() error = %v, want ErrUnknownKind", err)
+	}
+}
+
+// TestScanFindsGeneratedIdentifiers embeds generated identifiers in prose and
+// checks that each one is found with its canonical value
+func TestScanFindsGeneratedIdentifiers(t *testing.T) {
+	g := fake.New(1)
+	for i := 0; i < 200; i++ {
+		cin, phone, rib, taxID := g.CIN(), g.PhoneNumber(), g.RIB(), g.TaxID()
+		text := fmt.Sprintf("Bonjour, ma CIN est %s et mon numéro %s %s %s. Virement sur %s (MF %s) svp.",
+			cin, phone[:2], phone[2:5], phone[5:], rib, strings.ToLower(taxID))
+
+		var got []string
+		for _, m := range Scan(text) {
+			got = append(got, m.Kind+"="+m.Value)
+		}
+		want := []string{"cin=" + cin, "phone=+216" + phone, "rib=" + rib, "taxID=" + taxID}
+		if strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Fatalf("Scan(%q) = %v, want %v", text, got, want)
+		}
+	}
+}
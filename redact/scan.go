@@ -0,0 +1,459 @@
+package redact
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/normalize"
+	"github.com/degache-go/degache/validators"
+)
+
+// The scanner works on the normalized characters of the text, keeping the
+// byte offsets of the originals: Arabic-Indic digits count as digits and
+// invisible characters (bidirectional marks, zero-width spaces) are skipped.
+// Digits separated by a single space, dot or dash form a run of groups, and a
+// candidate is a sequence of groups laid out like an identifier ("20 123 456"
+// but not the date "15.01.2024"), checked by its validator. The whole run must
+// be read as identifiers: "20 123 456 789" does not contain a phone number.
+
+// cell is a visible character of the text
+type cell struct {
+	// r is the normalized character
+	r rune
+	// start and end are the byte offsets of the character in the text
+	start, end int
+}
+
+// group is a sequence of digits without separators
+type group struct {
+	digits string
+	// first and last are the indexes of the first and last cells of the group
+	first, last int
+}
+
+// layout is a way of writing an identifier as groups of digits
+type layout []int
+
+// Layouts of 20-digit RIBs and 8-digit CINs and phone numbers
+var (
+	ribLayouts        = []layout{{20}, {2, 3, 13, 2}, {5, 13, 2}, {4, 4, 4, 4, 4}}
+	eightDigitLayouts = []layout{{8}, {2, 3, 3}, {2, 2, 2, 2}}
+)
+
+// countryCode is the country calling code without "+"
+var countryCode = strings.TrimPrefix(constants.CountryCode, "+")
+
+// taxIDTailLayout is the part of a Tax ID after its 7-digit number: 9 is a digit, A a letter
+const taxIDTailLayout = "A/A/A/999"
+
+// Base confidence of the candidates accepted by their validator
+const (
+	cinConfidence           = 0.6
+	phoneConfidence         = 0.7
+	internationalConfidence = 0.9 // phone number written with the country code
+	ribConfidence           = 0.8
+	ribKeyConfidence        = 0.95 // RIB whose key is correct
+	taxIDConfidence         = 0.95
+	invalidConfidence       = 0.2
+	contextBoost            = 0.3 // added when a keyword of the kind precedes the candidate
+)
+
+// contextBytes is the length of the text searched for keywords before a candidate
+const contextBytes = 40
+
+// keywords announce an identifier of each kind, in French, Arabic and English.
+// They only count as whole words, so "cin" does not match "médecin".
+var keywords = map[string][]string{
+	KindCIN: {"cin", "c.i.n", "identité", "identite", "بطاقة التعريف", "ب.ت.و", "ب ت و"},
+	KindPhone: {"tel", "tél", "téléphone", "telephone", "phone", "portable", "mobile", "gsm", "whatsapp",
+		"appel", "appelez", "appeler", "هاتف", "الهاتف", "جوال", "الجوال"},
+	KindRIB:   {"rib", "r.i.b", "compte", "iban", "virement", "حساب", "الحساب"},
+	KindTaxID: {"matricule", "fiscal", "fiscale", "m.f", "mf", "جبائي", "الجبائي"},
+}
+
+// scanner finds candidate identifiers in a text
+type scanner struct {
+	text  string
+	cells []cell
+}
+
+// newScanner splits text into cells
+func newScanner(text string) *scanner {
+	s := &scanner{text: text, cells: make([]cell, 0, len(text))}
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if n := normalize.Rune(r); n >= 0 {
+			s.cells = append(s.cells, cell{r: n, start: i, end: i + size})
+		}
+		i += size
+	}
+	return s
+}
+
+// isDigit reports whether r is an ASCII digit
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// isSeparator reports whether r may separate the groups of digits of an identifier
+func isSeparator(r rune) bool {
+	return r == ' ' || r == '.' || r == '-'
+}
+
+// isWord reports whether the cell at index c is a letter or a digit, so that
+// an identifier cannot start or end next to it
+func (s *scanner) isWord(c int) bool {
+	if c < 0 || c >= len(s.cells) {
+		return false
+	}
+	r := s.cells[c].r
+	return isDigit(r) || unicode.IsLetter(r)
+}
+
+// candidates returns the candidates of the enabled kinds, in text order
+func (s *scanner) candidates(enabled map[string]bool, includeInvalid bool) []Match {
+	var matches []Match
+	for c := 0; c < len(s.cells); {
+		if !isDigit(s.cells[c].r) {
+			c++
+			continue
+		}
+		groups := s.run(c)
+		matches = append(matches, s.match(groups, enabled, includeInvalid)...)
+		c = groups[len(groups)-1].last + 1
+	}
+	return matches
+}
+
+// run returns the groups of the run of digits starting at cell c
+func (s *scanner) run(c int) []group {
+	var groups []group
+	for {
+		var digits strings.Builder
+		end := c
+		for end < len(s.cells) && isDigit(s.cells[end].r) {
+			digits.WriteRune(s.cells[end].r)
+			end++
+		}
+		groups = append(groups, group{digits: digits.String(), first: c, last: end - 1})
+
+		if end+1 >= len(s.cells) || !isSeparator(s.cells[end].r) || !isDigit(s.cells[end+1].r) {
+			return groups
+		}
+		c = end + 1
+	}
+}
+
+// option is a possible reading of groups of digits
+type option struct {
+	kind  string
+	value string
+	// first and last are the indexes of the first and last cells of the candidate
+	first, last int
+	// next is the index of the first group after the candidate
+	next          int
+	international bool
+}
+
+// match returns the candidates found in a run of groups
+func (s *scanner) match(groups []group, enabled map[string]bool, includeInvalid bool) []Match {
+	plus := groups[0].first > 0 && s.cells[groups[0].first-1].r == '+'
+	if plus && !strings.HasPrefix(groups[0].digits, countryCode) {
+		return nil // a foreign phone number
+	}
+
+	var matches []Match
+	tiled := make(map[int]bool)
+	for i := 0; i < len(groups); {
+		if i == 0 && s.isWord(groups[0].first-1) {
+			i++
+			continue
+		}
+
+		// The first valid reading wins; the first invalid one is reported if
+		// none is valid. Readings of other kinds or not reported are skipped.
+		var best *Match
+		next := 0
+		for _, o := range s.options(groups, i, plus) {
+			if !s.ends(groups, o, tiled) {
+				continue
+			}
+			if next == 0 {
+				next = o.next
+			}
+			if !enabled[o.kind] {
+				continue
+			}
+			m := s.check(o)
+			if m.Valid {
+				best, next = &m, o.next
+				break
+			}
+			if best == nil && includeInvalid {
+				best, next = &m, o.next
+			}
+		}
+		if next == 0 {
+			// The group is not an identifier but may precede one, as a date does
+			// in "15.01.2024 12345678"
+			next = i + 1
+		}
+		if best != nil {
+			matches = append(matches, *best)
+		}
+		i = next
+	}
+	return matches
+}
+
+// tiles reports whether the groups from index i to the end of the run can be
+// read as a sequence of identifiers, valid or not, each ending as ends
+// requires. Results are cached in tiled.
+func (s *scanner) tiles(groups []group, i int, tiled map[int]bool) bool {
+	if i == len(groups) {
+		return true
+	}
+	if ok, cached := tiled[i]; cached {
+		return ok
+	}
+
+	tiled[i] = false
+	for _, o := range s.options(groups, i, false) {
+		if s.ends(groups, o, tiled) {
+			tiled[i] = true
+			break
+		}
+	}
+	return tiled[i]
+}
+
+// ends reports whether reading o ends where the identifier does: it is
+// followed by another reading, by the end of the run or by a number that does
+// not continue its digit grouping. "20 123 456 789" is the beginning of a
+// longer number, while "20 123 456 10 fois" and "12345678 3 fois" are not.
+func (s *scanner) ends(groups []group, o option, tiled map[int]bool) bool {
+	if s.tiles(groups, o.next, tiled) {
+		return true
+	}
+	last := groups[o.next-1]
+	grouped := last.first > o.first
+	return !grouped || len(groups[o.next].digits) != len(last.digits)
+}
+
+// options returns the possible readings of the groups starting at index i, most specific first
+func (s *scanner) options(groups []group, i int, plus bool) []option {
+	var options []option
+
+	if i == len(groups)-1 && len(groups[i].digits) == 7 {
+		if tail, last, ok := s.taxIDTail(groups[i].last + 1); ok {
+			options = append(options, option{kind: KindTaxID, value: groups[i].digits + tail,
+				first: groups[i].first, last: last, next: i + 1})
+		}
+	}
+
+	for _, l := range ribLayouts {
+		if next, ok := s.fits(groups, i, l); ok {
+			options = append(options, option{kind: KindRIB, value: joinDigits(groups[i:next]),
+				first: groups[i].first, last: groups[next-1].last, next: next})
+		}
+	}
+
+	// Phone numbers with the country code: "+216 20 123 456", "0021620123456"
+	first := groups[i].first
+	if i == 0 && plus {
+		first--
+	}
+	country := countryCode
+	switch digits := groups[i].digits; {
+	case digits == country || digits == "00"+country:
+		for _, l := range eightDigitLayouts {
+			if next, ok := s.fits(groups, i+1, l); ok {
+				options = append(options, phoneOption(joinDigits(groups[i+1:next]), first, groups[next-1].last, next))
+			}
+		}
+	case len(digits) == len(country)+8 && strings.HasPrefix(digits, country):
+		if _, ok := s.fits(groups, i, layout{len(digits)}); ok {
+			options = append(options, phoneOption(digits[len(country):], first, groups[i].last, i+1))
+		}
+	case len(digits) == len(country)+10 && strings.HasPrefix(digits, "00"+country):
+		if _, ok := s.fits(groups, i, layout{len(digits)}); ok {
+			options = append(options, phoneOption(digits[len(country)+2:], first, groups[i].last, i+1))
+		}
+	}
+
+	for _, l := range eightDigitLayouts {
+		if next, ok := s.fits(groups, i, l); ok {
+			digits := joinDigits(groups[i:next])
+			kind := KindPhone
+			if digits[0] == '0' || digits[0] == '1' {
+				kind = KindCIN
+			}
+			options = append(options, option{kind: kind, value: digits,
+				first: groups[i].first, last: groups[next-1].last, next: next})
+		}
+	}
+	return options
+}
+
+// phoneOption returns the reading of a phone number written with the country code
+func phoneOption(national string, first, last, next int) option {
+	return option{kind: KindPhone, value: national, first: first, last: last, next: next, international: true}
+}
+
+// fits reports whether the groups starting at index i follow layout l and
+// returns the index of the group after them. Groups ending the run must not be
+// followed by a letter.
+func (s *scanner) fits(groups []group, i int, l layout) (next int, ok bool) {
+	if i+len(l) > len(groups) {
+		return 0, false
+	}
+	for j, n := range l {
+		if len(groups[i+j].digits) != n {
+			return 0, false
+		}
+	}
+	next = i + len(l)
+	if next == len(groups) && s.isWord(groups[next-1].last+1) {
+		return 0, false
+	}
+	return next, true
+}
+
+// joinDigits concatenates the digits of groups
+func joinDigits(groups []group) string {
+	var b strings.Builder
+	for _, g := range groups {
+		b.WriteString(g.digits)
+	}
+	return b.String()
+}
+
+// taxIDTail matches the key, codes and establishment number of a Tax ID
+// ("A/P/M/000") at cell c, after an optional space, and returns them in upper
+// case with the index of their last cell
+func (s *scanner) taxIDTail(c int) (tail string, last int, ok bool) {
+	if c < len(s.cells) && s.cells[c].r == ' ' {
+		c++
+	}
+
+	var b strings.Builder
+	for _, want := range taxIDTailLayout {
+		if c >= len(s.cells) {
+			return "", 0, false
+		}
+		r := s.cells[c].r
+		switch want {
+		case 'A':
+			r = unicode.ToUpper(r)
+			if r < 'A' || r > 'Z' {
+				return "", 0, false
+			}
+		case '9':
+			if !isDigit(r) {
+				return "", 0, false
+			}
+		default:
+			if r != want {
+				return "", 0, false
+			}
+		}
+		b.WriteRune(r)
+		c++
+	}
+	if s.isWord(c) {
+		return "", 0, false
+	}
+	return b.String(), c - 1, true
+}
+
+// check validates a reading and returns it as a match with its base confidence
+func (s *scanner) check(o option) Match {
+	start, end := s.cells[o.first].start, s.cells[o.last].end
+	m := Match{Kind: o.kind, Start: start, End: end, Text: s.text[start:end], Value: o.value}
+
+	var err error
+	switch o.kind {
+	case KindCIN:
+		err = validators.CheckCIN(o.value)
+		m.Confidence = cinConfidence
+	case KindPhone:
+		err = validators.CheckPhoneNumber(o.value)
+		m.Value = constants.CountryCode + o.value
+		m.Confidence = phoneConfidence
+		if o.international {
+			m.Confidence = internationalConfidence
+		}
+	case KindRIB:
+		err = validators.CheckRIB(o.value)
+		m.Confidence = ribConfidence
+		if err == nil && validators.CheckRIBChecksum(o.value) == nil {
+			m.Confidence = ribKeyConfidence
+		}
+	case KindTaxID:
+		err = validators.CheckTaxID(o.value)
+		m.Confidence = taxIDConfidence
+	}
+
+	m.Valid = err == nil
+	if verr := validators.AsValidationError(err); verr != nil {
+		m.Code = verr.Code
+	}
+	if !m.Valid {
+		m.Confidence = invalidConfidence
+	}
+	return m
+}
+
+// containsWord reports whether keyword appears in context as a whole word, that
+// is neither preceded nor followed by a letter or a digit. before is the rune
+// preceding context in the text.
+func containsWord(context, keyword string, before rune) bool {
+	for i := 0; i < len(context); {
+		j := strings.Index(context[i:], keyword)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(keyword)
+
+		prev := before
+		if start > 0 {
+			prev, _ = utf8.DecodeLastRuneInString(context[:start])
+		}
+		next, _ := utf8.DecodeRuneInString(context[end:])
+		if !isWordRune(prev) && (end == len(context) || !isWordRune(next)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(context[start:])
+		i = start + size
+	}
+	return false
+}
+
+// isWordRune reports whether r is part of a word: a letter, a digit or a combining mark
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// confidence adds contextBoost to the base confidence of m when a keyword of
+// its kind appears in the contextBytes before it
+func confidence(text string, m Match) float64 {
+	start := max(m.Start-contextBytes, 0)
+	for start < m.Start && !utf8.RuneStart(text[start]) {
+		start++
+	}
+	context := strings.ToLower(text[start:m.Start])
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+
+	c := m.Confidence
+	for _, keyword := range keywords[m.Kind] {
+		if containsWord(context, keyword, before) {
+			c = min(c+contextBoost, 1)
+			break
+		}
+	}
+	return math.Round(c*100) / 100
+}
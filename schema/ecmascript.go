@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/validators"
+)
+
+// Regex is the ECMAScript form of a syntax, for client-side checks in web forms
+type Regex struct {
+	Name string `json:"name"`
+	// Validator is the name of the degache validator accepting the values, if any
+	Validator string `json:"validator,omitempty"`
+	// Source is the pattern, usable with new RegExp(source) and as an HTML pattern attribute
+	Source string `json:"source"`
+	// Literal is the regular expression literal (e.g. /^[0-9]{4}$/)
+	Literal   string   `json:"literal"`
+	Masks     []string `json:"masks"`
+	MinLength int      `json:"minLength"`
+	MaxLength int      `json:"maxLength"`
+}
+
+// ECMAScript returns the ECMAScript regular expressions and input masks of identifiers.
+// A regular expression accepts exactly the values accepted by the validator in
+// strict mode; masks use 9 for a digit and A for an uppercase letter.
+//
+// Parameters:
+//   - names: The syntax names (case-insensitive, see Names); all syntaxes if none is given
+//
+// Returns:
+//   - []Regex: the regular expressions in the order of names
+//   - error: ErrUnknownSchema if a name has no syntax
+//
+// Example:
+//
+//	regexes, _ := ECMAScript("phone")
+//	fmt.Println(regexes[0].Literal, regexes[0].Masks[0]) // /^[2459][0-9]{7}$/ 99 999 999
+func ECMAScript(names ...string) ([]Regex, error) {
+	if len(names) == 0 {
+		names = Names()
+	}
+
+	regexes := make([]Regex, 0, len(names))
+	for _, name := range names {
+		syntax, ok := validators.SyntaxOf(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownSchema, name)
+		}
+		regexes = append(regexes, Regex{
+			Name:      syntax.Name,
+			Validator: syntax.Validator,
+			Source:    syntax.Pattern,
+			Literal:   "/" + strings.ReplaceAll(syntax.Pattern, "/", `\/`) + "/",
+			Masks:     syntax.Masks,
+			MinLength: syntax.MinLength,
+			MaxLength: syntax.MaxLength,
+		})
+	}
+	return regexes, nil
+}
+
+// ESModule returns a JavaScript module exporting one constant per identifier,
+// holding its regular expression, masks and length bounds:
+//
+//	export const postal = {
+//	  pattern: /^[0-9]{4}$/,
+//	  masks: ["9999"],
+//	  minLength: 4,
+//	  maxLength: 4,
+//	};
+//
+// Parameters:
+//   - names: The syntax names; all syntaxes if none is given
+//
+// Returns:
+//   - []byte: the module source
+//   - error: ErrUnknownSchema if a name has no syntax
+func ESModule(names ...string) ([]byte, error) {
+	regexes, err := ECMAScript(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by degache patterns --js; DO NOT EDIT.\n")
+	for _, r := range regexes {
+		masks, err := json.Marshal(r.Masks)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "\nexport const %s = {\n", r.Name)
+		fmt.Fprintf(&b, "  pattern: %s,\n", r.Literal)
+		fmt.Fprintf(&b, "  masks: %s,\n", strings.ReplaceAll(string(masks), `","`, `", "`))
+		fmt.Fprintf(&b, "  minLength: %d,\n", r.MinLength)
+		fmt.Fprintf(&b, "  maxLength: %d,\n", r.MaxLength)
+		b.WriteString("};\n")
+	}
+	return b.Bytes(), nil
+}
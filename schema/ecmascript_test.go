@@ -0,0 +1,220 @@
+package schema
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// goRegexp compiles a regular expression literal with the Go engine
+func goRegexp(t *testing.T, literal string) *syntax.Regexp {
+	t.Helper()
+	if !strings.HasPrefix(literal, "/") || !strings.HasSuffix(literal, "/") {
+		t.Fatalf("%s is not a regular expression literal", literal)
+	}
+	re, err := syntax.Parse(strings.ReplaceAll(literal[1:len(literal)-1], `\/`, "/"), syntax.Perl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return re
+}
+
+// portable reports whether re only uses constructs that have the same meaning in ECMAScript
+func portable(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpLiteral, syntax.OpCharClass, syntax.OpBeginText, syntax.OpEndText:
+		return re.Flags&syntax.FoldCase == 0
+	case syntax.OpConcat, syntax.OpAlternate, syntax.OpRepeat, syntax.OpQuest, syntax.OpStar, syntax.OpPlus:
+		for _, sub := range re.Sub {
+			if !portable(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// generate appends a random string matching re to b
+func generate(re *syntax.Regexp, rng *rand.Rand, b *strings.Builder) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		i := rng.Intn(len(re.Rune)/2) * 2
+		b.WriteRune(re.Rune[i] + rune(rng.Intn(int(re.Rune[i+1]-re.Rune[i])+1)))
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			generate(sub, rng, b)
+		}
+	case syntax.OpAlternate:
+		generate(re.Sub[rng.Intn(len(re.Sub))], rng, b)
+	case syntax.OpRepeat:
+		n := re.Min
+		if re.Max > re.Min {
+			n += rng.Intn(re.Max - re.Min + 1)
+		}
+		for i := 0; i < n; i++ {
+			generate(re.Sub[0], rng, b)
+		}
+	}
+}
+
+// mutate replaces, inserts or deletes one or two characters of s
+func mutate(s string, rng *rand.Rand) string {
+	alphabet := []rune("0123456789AMPZaz /+-RSتونس٣")
+	runes := []rune(s)
+	for n := 1 + rng.Intn(2); n > 0; n-- {
+		i := rng.Intn(len(runes) + 1)
+		r := alphabet[rng.Intn(len(alphabet))]
+		switch op := rng.Intn(3); {
+		case op == 0 && i < len(runes):
+			runes[i] = r
+		case op == 1 && i < len(runes):
+			runes = append(runes[:i], runes[i+1:]...)
+		default:
+			runes = append(runes[:i], append([]rune{r}, runes[i:]...)...)
+		}
+	}
+	return string(runes)
+}
+
+// accepts reports whether the Go validators accept s as a value of the named syntax in strict mode
+func accepts(name, s string) bool {
+	strict := types.ValidationOptions{Strict: true}
+	switch name {
+	case "cin":
+		return validators.ValidateCIN(s, strict)
+	case "phone", "phoneE164":
+		return strings.HasPrefix(s, "+") == (name == "phoneE164") &&
+			validators.ValidatePhoneNumber(s, types.PhoneNumberValidationOptions{Strict: true})
+	case "taxID":
+		return validators.ValidateTaxID(s, strict)
+	case "rib":
+		return validators.ValidateRIB(s, strict)
+	case "iban":
+		return len(s) == 24 && strings.HasPrefix(s, "TN") && strings.Trim(s[2:4], "0123456789") == "" &&
+			validators.ValidateRIB(s[4:], strict)
+	case "postal":
+		return validators.ValidatePostalCode(s, strict)
+	case "carPlate":
+		return validators.ValidateCarPlate(s, types.CarPlateValidationOptions{Strict: true}) ||
+			validators.ValidateCarPlate(s, types.CarPlateValidationOptions{Type: "special", Strict: true})
+	}
+	return false
+}
+
+// corpus generates values of every syntax: matches of its pattern and mutations of them
+func corpus(t *testing.T, regexes []Regex) map[string][]string {
+	rng := rand.New(rand.NewSource(1))
+	values := make(map[string][]string)
+	for _, r := range regexes {
+		re := goRegexp(t, r.Literal)
+		for i := 0; i < 500; i++ {
+			var b strings.Builder
+			generate(re, rng, &b)
+			values[r.Name] = append(values[r.Name], b.String(), mutate(b.String(), rng))
+		}
+	}
+	return values
+}
+
+func TestECMAScript(t *testing.T) {
+	regexes, err := ECMAScript("phone", "TAXID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regexes) != 2 || regexes[0].Literal != "/^[2459][0-9]{7}$/" || regexes[0].Masks[0] != "99 999 999" {
+		t.Errorf("ECMAScript(phone) = %+v", regexes[0])
+	}
+	if regexes[1].Name != "taxID" || regexes[1].Literal != `/^[0-9]{7}[A-Z]\/[A-Z]\/[A-Z]\/[0-9]{3}$/` {
+		t.Errorf("ECMAScript(TAXID) = %+v", regexes[1])
+	}
+
+	plates, _ := ECMAScript("carPlate")
+	if strings.Join(plates[0].Masks, "|") != "999 تونس 9999|RS 999 تونس" {
+		t.Errorf("car plate masks = %q", plates[0].Masks)
+	}
+}
+
+// TestECMAScriptMatchesValidators checks every exported regular expression
+// against the Go validators on a generated corpus: with the Go engine, after
+// checking that the expression only uses portable constructs, and with
+// Node.js when it is installed
+func TestECMAScriptMatchesValidators(t *testing.T) {
+	regexes, err := ECMAScript()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := corpus(t, regexes)
+
+	for _, r := range regexes {
+		re := goRegexp(t, r.Literal)
+		if !portable(re) {
+			t.Errorf("%s: %s is not portable to ECMAScript", r.Name, r.Literal)
+		}
+		matcher := regexp.MustCompile(re.String())
+		for _, s := range values[r.Name] {
+			if matcher.MatchString(s) != accepts(r.Name, s) {
+				t.Errorf("%s: %s matches %q = %v, validator = %v", r.Name, r.Literal, s, !accepts(r.Name, s), accepts(r.Name, s))
+			}
+		}
+	}
+
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("Node.js is not installed")
+	}
+	module, err := ESModule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	corpusJSON, _ := json.Marshal(values)
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"patterns.mjs": module,
+		"corpus.json":  corpusJSON,
+		"check.mjs": []byte(`import * as patterns from "./patterns.mjs";
+import { readFileSync } from "node:fs";
+const corpus = JSON.parse(readFileSync(new URL("./corpus.json", import.meta.url)));
+const results = {};
+for (const [name, values] of Object.entries(corpus)) {
+  results[name] = values.map((s) => patterns[name].pattern.test(s));
+}
+console.log(JSON.stringify(results));
+`),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := exec.Command(node, filepath.Join(dir, "check.mjs")).Output()
+	if err != nil {
+		t.Fatalf("node: %v", err)
+	}
+	var results map[string][]bool
+	if err := json.Unmarshal(out, &results); err != nil || len(results) != len(values) {
+		t.Fatalf("node output %s: %v", out, err)
+	}
+	for name, matches := range results {
+		if len(matches) != len(values[name]) {
+			t.Fatalf("%s: node checked %d values, want %d", name, len(matches), len(values[name]))
+		}
+		for i, match := range matches {
+			if s := values[name][i]; match != accepts(name, s) {
+				t.Errorf("%s: JavaScript matches %q = %v, validator = %v", name, s, match, accepts(name, s))
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Package schema generates JSON Schema (2020-12) definitions, ECMAScript
+// regular expressions and input masks for Tunisian identifiers, for validating
+// payloads in browsers, API gateways and other JSON Schema consumers.
+//
+// The definitions are derived from validators.Syntaxes, so their patterns
+// accept exactly the values accepted by the validators in strict mode. JSON
+// Schema definitions carry a title and description in Arabic, French or English.
+//
+// Example usage:
+//
+//	doc, _ := schema.JSONSchema(i18n.French)
+//	os.Stdout.Write(doc)
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/validators"
+)
+
+// Dialect is the JSON Schema dialect of the generated documents
+const Dialect = "https://json-schema.org/draft/2020-12/schema"
+
+// OpenAPIVersion is the version of the generated OpenAPI documents, the first to use JSON Schema 2020-12
+const OpenAPIVersion = "3.1.0"
+
+// ErrUnknownSchema is returned for a name that has no schema
+var ErrUnknownSchema = errors.New("unknown schema")
+
+// Schema is a JSON Schema definition of a string identifier
+type Schema struct {
+	Type        string   `json:"type"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Pattern     string   `json:"pattern"`
+	MinLength   int      `json:"minLength"`
+	MaxLength   int      `json:"maxLength"`
+	Examples    []string `json:"examples,omitempty"`
+	// Validator is the name of the degache validator accepting the values, if any
+	Validator string `json:"x-degache-validator,omitempty"`
+}
+
+// Names returns the names of the available schemas
+//
+// Returns:
+//   - []string: cin, phone, phoneE164, taxID, rib, iban, postal and carPlate
+func Names() []string {
+	syntaxes := validators.Syntaxes()
+	names := make([]string, len(syntaxes))
+	for i, s := range syntaxes {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// For returns the schema of an identifier
+//
+// Parameters:
+//   - name: The schema name (case-insensitive, see Names)
+//   - locale: The language of the title and description
+//
+// Returns:
+//   - *Schema: the schema
+//   - error: ErrUnknownSchema if there is no schema with that name
+//
+// Example:
+//
+//	s, _ := For("rib", i18n.Arabic)
+//	fmt.Println(s.Pattern)
+func For(name string, locale i18n.Locale) (*Schema, error) {
+	syntax, ok := validators.SyntaxOf(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSchema, name)
+	}
+
+	return &Schema{
+		Type:        "string",
+		Title:       text(locale, syntax.Name+".title"),
+		Description: text(locale, syntax.Name+".description"),
+		Pattern:     syntax.Pattern,
+		MinLength:   syntax.MinLength,
+		MaxLength:   syntax.MaxLength,
+		Examples:    syntax.Examples,
+		Validator:   syntax.Validator,
+	}, nil
+}
+
+// Definitions returns the schemas of several identifiers, keyed by name
+//
+// Parameters:
+//   - locale: The language of the titles and descriptions
+//   - names: The schema names; all schemas if none is given
+//
+// Returns:
+//   - map[string]*Schema: the schemas keyed by their canonical name
+//   - error: ErrUnknownSchema if a name has no schema
+func Definitions(locale i18n.Locale, names ...string) (map[string]*Schema, error) {
+	if len(names) == 0 {
+		names = Names()
+	}
+
+	defs := make(map[string]*Schema, len(names))
+	for _, name := range names {
+		s, err := For(name, locale)
+		if err != nil {
+			return nil, err
+		}
+		syntax, _ := validators.SyntaxOf(name)
+		defs[syntax.Name] = s
+	}
+	return defs, nil
+}
+
+// JSONSchema returns a JSON Schema document holding the definitions in $defs,
+// to be referenced as "<document>#/$defs/<name>"
+//
+// Parameters:
+//   - locale: The language of the titles and descriptions
+//   - names: The schema names; all schemas if none is given
+//
+// Returns:
+//   - []byte: the indented JSON document
+//   - error: ErrUnknownSchema if a name has no schema
+func JSONSchema(locale i18n.Locale, names ...string) ([]byte, error) {
+	defs, err := Definitions(locale, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(struct {
+		Schema string             `json:"$schema"`
+		Defs   map[string]*Schema `json:"$defs"`
+	}{Dialect, defs}, "", "  ")
+}
+
+// OpenAPI returns an OpenAPI document fragment holding the definitions in
+// components.schemas, to be referenced as "#/components/schemas/<name>"
+//
+// Parameters:
+//   - locale: The language of the titles and descriptions
+//   - names: The schema names; all schemas if none is given
+//
+// Returns:
+//   - []byte: the indented JSON document
+//   - error: ErrUnknownSchema if a name has no schema
+func OpenAPI(locale i18n.Locale, names ...string) ([]byte, error) {
+	defs, err := Definitions(locale, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	type components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+	return json.MarshalIndent(struct {
+		OpenAPI    string     `json:"openapi"`
+		Components components `json:"components"`
+	}{OpenAPIVersion, components{defs}}, "", "  ")
+}
+
+// text returns the localized text of key, falling back to English
+func text(locale i18n.Locale, key string) string {
+	template, ok := texts[locale][key]
+	if !ok {
+		template = texts[i18n.English][key]
+	}
+
+	return strings.NewReplacer(
+		"{prefixes}", strings.Join(constants.ValidPrefixes, ", "),
+		"{countryCode}", constants.CountryCode,
+	).Replace(template)
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/i18n"
+)
+
+func TestFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		locale  i18n.Locale
+		title   string
+		pattern string
+		min     int
+		max     int
+	}{
+		{"cin", i18n.English, "CIN", "^[01][0-9]{7}$", 8, 8},
+		{"PHONE", i18n.French, "Numéro de téléphone", "^[2459][0-9]{7}$", 8, 8},
+		{"phoneE164", i18n.English, "Phone number (E.164)", `^\+216[2459][0-9]{7}$`, 12, 12},
+		{"taxID", i18n.French, "Matricule fiscal", "^[0-9]{7}[A-Z]/[A-Z]/[A-Z]/[0-9]{3}$", 16, 16},
+		{"postal", i18n.Arabic, "الرمز البريدي", "^[0-9]{4}$", 4, 4},
+		{"carPlate", i18n.Locale("de"), "Car plate", "", 11, 13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := For(tt.name, tt.locale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Type != "string" || s.Title != tt.title || s.MinLength != tt.min || s.MaxLength != tt.max {
+				t.Errorf("For(%q) = %+v, want title %q and length [%d, %d]", tt.name, s, tt.title, tt.min, tt.max)
+			}
+			if tt.pattern != "" && s.Pattern != tt.pattern {
+				t.Errorf("For(%q).Pattern = %s, want %s", tt.name, s.Pattern, tt.pattern)
+			}
+		})
+	}
+
+	if _, err := For("ssn", i18n.English); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("For(ssn) error = %v, want ErrUnknownSchema", err)
+	}
+}
+
+// TestEverySchema checks that every schema has localized texts without
+// placeholders and that its examples match its pattern
+func TestEverySchema(t *testing.T) {
+	for _, name := range Names() {
+		for _, locale := range []i18n.Locale{i18n.English, i18n.French, i18n.Arabic} {
+			if _, ok := texts[locale][name+".description"]; !ok {
+				t.Errorf("%s: no %s description", name, locale)
+			}
+			s, _ := For(name, locale)
+			if s.Title == "" || strings.Contains(s.Description, "{") {
+				t.Errorf("%s (%s): title %q, description %q", name, locale, s.Title, s.Description)
+			}
+			for _, example := range s.Examples {
+				if !regexp.MustCompile(s.Pattern).MatchString(example) {
+					t.Errorf("%s: example %q does not match %s", name, example, s.Pattern)
+				}
+			}
+		}
+	}
+}
+
+func TestDocuments(t *testing.T) {
+	doc, err := JSONSchema(i18n.English, "rib", "iban")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsonSchema struct {
+		Schema string                     `json:"$schema"`
+		Defs   map[string]json.RawMessage `json:"$defs"`
+	}
+	if err := json.Unmarshal(doc, &jsonSchema); err != nil {
+		t.Fatal(err)
+	}
+	if jsonSchema.Schema != Dialect || len(jsonSchema.Defs) != 2 || jsonSchema.Defs["iban"] == nil {
+		t.Errorf("JSONSchema() = %s", doc)
+	}
+	if !strings.Contains(string(jsonSchema.Defs["rib"]), `"x-degache-validator": "rib"`) {
+		t.Errorf("rib definition = %s, want x-degache-validator", jsonSchema.Defs["rib"])
+	}
+
+	doc, err = OpenAPI(i18n.Arabic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var openAPI struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]Schema `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(doc, &openAPI); err != nil {
+		t.Fatal(err)
+	}
+	if openAPI.OpenAPI != OpenAPIVersion || len(openAPI.Components.Schemas) != len(Names()) {
+		t.Errorf("OpenAPI() = %s", doc)
+	}
+
+	if _, err := JSONSchema(i18n.English, "cin", "ssn"); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("JSONSchema(cin, ssn) error = %v, want ErrUnknownSchema", err)
+	}
+}
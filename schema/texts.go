@@ -0,0 +1,62 @@
+package schema
+
+import "github.com/degache-go/degache/i18n"
+
+// texts contains the titles and descriptions of the schemas in every locale.
+// Descriptions may contain the placeholders {prefixes} and {countryCode}.
+var texts = map[i18n.Locale]map[string]string{
+	i18n.English: {
+		"cin.title":             "CIN",
+		"cin.description":       "Tunisian national identity card number: 8 digits starting with 0 or 1.",
+		"phone.title":           "Phone number",
+		"phone.description":     "Tunisian phone number in national format: 8 digits starting with {prefixes}.",
+		"phoneE164.title":       "Phone number (E.164)",
+		"phoneE164.description": "Tunisian phone number in E.164 format: {countryCode} followed by 8 digits starting with {prefixes}.",
+		"taxID.title":           "Tax ID",
+		"taxID.description":     "Tunisian tax ID (matricule fiscal): 7 digits and a control letter, followed by the VAT code, the category code and the 3-digit establishment number, separated by slashes.",
+		"rib.title":             "RIB",
+		"rib.description":       "Tunisian bank account number (RIB): 20 digits starting with a known 2-digit bank code.",
+		"iban.title":            "IBAN",
+		"iban.description":      "Tunisian IBAN: TN, 2 check digits and a 20-digit RIB. The pattern does not verify the check digits (ISO 7064 mod 97-10).",
+		"postal.title":          "Postal code",
+		"postal.description":    "Tunisian postal code: 4 digits.",
+		"carPlate.title":        "Car plate",
+		"carPlate.description":  "Tunisian car plate: 3 digits, تونس and 4 digits for standard plates, or RS, 3 digits and تونس for special plates.",
+	},
+	i18n.French: {
+		"cin.title":             "CIN",
+		"cin.description":       "Numéro de carte d'identité nationale tunisienne : 8 chiffres commençant par 0 ou 1.",
+		"phone.title":           "Numéro de téléphone",
+		"phone.description":     "Numéro de téléphone tunisien au format national : 8 chiffres commençant par {prefixes}.",
+		"phoneE164.title":       "Numéro de téléphone (E.164)",
+		"phoneE164.description": "Numéro de téléphone tunisien au format E.164 : {countryCode} suivi de 8 chiffres commençant par {prefixes}.",
+		"taxID.title":           "Matricule fiscal",
+		"taxID.description":     "Matricule fiscal tunisien : 7 chiffres et une lettre de contrôle, suivis du code TVA, du code catégorie et du numéro d'établissement à 3 chiffres, séparés par des barres obliques.",
+		"rib.title":             "RIB",
+		"rib.description":       "Relevé d'identité bancaire tunisien : 20 chiffres commençant par un code banque connu à 2 chiffres.",
+		"iban.title":            "IBAN",
+		"iban.description":      "IBAN tunisien : TN, 2 chiffres de contrôle et un RIB de 20 chiffres. Le motif ne vérifie pas les chiffres de contrôle (ISO 7064 mod 97-10).",
+		"postal.title":          "Code postal",
+		"postal.description":    "Code postal tunisien : 4 chiffres.",
+		"carPlate.title":        "Plaque d'immatriculation",
+		"carPlate.description":  "Plaque d'immatriculation tunisienne : 3 chiffres, تونس et 4 chiffres pour les plaques standard, ou RS, 3 chiffres et تونس pour les plaques spéciales.",
+	},
+	i18n.Arabic: {
+		"cin.title":             "رقم بطاقة التعريف الوطنية",
+		"cin.description":       "رقم بطاقة التعريف الوطنية التونسية: 8 أرقام تبدأ بـ 0 أو 1.",
+		"phone.title":           "رقم الهاتف",
+		"phone.description":     "رقم هاتف تونسي بالصيغة الوطنية: 8 أرقام تبدأ بـ {prefixes}.",
+		"phoneE164.title":       "رقم الهاتف (E.164)",
+		"phoneE164.description": "رقم هاتف تونسي بصيغة E.164: {countryCode} متبوعا بـ 8 أرقام تبدأ بـ {prefixes}.",
+		"taxID.title":           "المعرف الجبائي",
+		"taxID.description":     "المعرف الجبائي التونسي: 7 أرقام وحرف مراقبة، متبوعة برمز الأداء على القيمة المضافة ورمز الصنف ورقم المؤسسة المتكون من 3 أرقام، مفصولة بخطوط مائلة.",
+		"rib.title":             "رقم الحساب البنكي",
+		"rib.description":       "رقم الحساب البنكي التونسي (RIB): 20 رقما تبدأ برمز بنك معروف من رقمين.",
+		"iban.title":            "IBAN",
+		"iban.description":      "رقم IBAN التونسي: TN ثم رقمان للتحقق ثم رقم حساب بنكي من 20 رقما. لا يتحقق النمط من رقمي التحقق (ISO 7064 mod 97-10).",
+		"postal.title":          "الرمز البريدي",
+		"postal.description":    "الرمز البريدي التونسي: 4 أرقام.",
+		"carPlate.title":        "رقم اللوحة المنجمية",
+		"carPlate.description":  "لوحة منجمية تونسية: 3 أرقام ثم تونس ثم 4 أرقام للوحات العادية، أو RS ثم 3 أرقام ثم تونس للوحات الخاصة.",
+	},
+}
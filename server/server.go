@@ -0,0 +1,656 @@
+// Package server exposes the degache validators, formatters and lookups as a
+// JSON HTTP API, so that services written in other languages can apply the
+// same rules. It is built only on net/http.
+//
+// Endpoints:
+//
+//	GET|POST /v1/validate/{kind}           validate one value (cin, phone, taxID, rib, postal, carPlate)
+//	GET|POST /v1/format/{kind}             format one value (phone, currency, date)
+//	POST     /v1/batch/validate            validate many records
+//	POST     /v1/batch/format              format many values
+//	GET      /v1/validators, /v1/formats   list the validators and the format styles
+//	GET      /v1/banks[/{code or RIB}]     list or look up banks
+//	GET      /v1/carriers[/{phone}]        list or look up mobile carriers
+//	GET      /v1/governorates[/{postal code or name}]
+//	GET      /healthz, /readyz             liveness and readiness probes
+//	GET      /openapi.json                 the OpenAPI 3 description of the API
+//
+// Example usage:
+//
+//	srv := server.New(server.Config{MaxBatchSize: 1000})
+//	log.Fatal(http.ListenAndServe(":8080", srv))
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/formatters"
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/schema"
+	"github.com/degache-go/degache/types"
+	"github.com/degache-go/degache/validators"
+)
+
+// Default limits
+const (
+	// DefaultMaxBodyBytes is the default maximum size of a request body (1 MiB)
+	DefaultMaxBodyBytes = 1 << 20
+	// DefaultMaxBatchSize is the default maximum number of records or values of a batch request
+	DefaultMaxBatchSize = 10000
+)
+
+// openAPISource is the hand-written part of the OpenAPI description of the
+// API: the endpoints and their request and response bodies
+//
+//go:embed openapi.json
+var openAPISource []byte
+
+// openAPI returns the OpenAPI description of the API: openAPISource with the
+// identifier schemas of package schema added to its components, so that they
+// cannot drift from the validators
+var openAPI = sync.OnceValue(func() []byte {
+	var doc map[string]any
+	if err := json.Unmarshal(openAPISource, &doc); err != nil {
+		panic("server: invalid openapi.json: " + err.Error())
+	}
+	defs, err := schema.Definitions(i18n.English)
+	if err != nil {
+		panic("server: " + err.Error())
+	}
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+	for name, def := range defs {
+		schemas[name] = def
+	}
+	doc["openapi"] = schema.OpenAPIVersion
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		panic("server: " + err.Error())
+	}
+	return data
+})
+
+// kindAliases maps the short kinds accepted in URLs to validator names
+var kindAliases = map[string]string{
+	"plate": "carPlate",
+}
+
+// Config configures a Server
+type Config struct {
+	// Registry provides the validators (default validators.DefaultRegistry)
+	Registry *validators.Registry
+	// MaxBodyBytes is the maximum size of a request body (default DefaultMaxBodyBytes)
+	MaxBodyBytes int64
+	// MaxBatchSize is the maximum number of records or values of a batch request (default DefaultMaxBatchSize)
+	MaxBatchSize int
+}
+
+// Server is an http.Handler serving the degache API
+type Server struct {
+	config Config
+	mux    *http.ServeMux
+	ready  atomic.Bool
+}
+
+// New creates a Server, ready to serve requests
+//
+// Parameters:
+//   - config: The registry and the request limits
+//
+// Returns:
+//   - *Server: the server
+//
+// Example:
+//
+//	srv := New(Config{})
+//	http.ListenAndServe(":8080", srv)
+func New(config Config) *Server {
+	if config.Registry == nil {
+		config.Registry = validators.DefaultRegistry
+	}
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if config.MaxBatchSize <= 0 {
+		config.MaxBatchSize = DefaultMaxBatchSize
+	}
+
+	s := &Server{config: config, mux: http.NewServeMux()}
+	s.ready.Store(true)
+
+	s.handle("/healthz", s.handleHealth, http.MethodGet)
+	s.handle("/readyz", s.handleReady, http.MethodGet)
+	s.handle("/openapi.json", s.handleOpenAPI, http.MethodGet)
+	s.handle("/v1/openapi.json", s.handleOpenAPI, http.MethodGet)
+	s.handle("/v1/validate/", s.handleValidate, http.MethodGet, http.MethodPost)
+	s.handle("/v1/format/", s.handleFormat, http.MethodGet, http.MethodPost)
+	s.handle("/v1/batch/validate", s.handleBatchValidate, http.MethodPost)
+	s.handle("/v1/batch/format", s.handleBatchFormat, http.MethodPost)
+	s.handle("/v1/validators", s.handleValidators, http.MethodGet)
+	s.handle("/v1/formats", s.handleFormats, http.MethodGet)
+	s.handle("/v1/banks", s.handleBanks, http.MethodGet)
+	s.handle("/v1/banks/", s.handleBank, http.MethodGet)
+	s.handle("/v1/carriers", s.handleCarriers, http.MethodGet)
+	s.handle("/v1/carriers/", s.handleCarrier, http.MethodGet)
+	s.handle("/v1/governorates", s.handleGovernorates, http.MethodGet)
+	s.handle("/v1/governorates/", s.handleGovernorate, http.MethodGet)
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not_found", "no such endpoint: "+r.URL.Path)
+	})
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// SetReady sets the state reported by /readyz. Call SetReady(false) before
+// shutting down so that load balancers stop routing requests to the server.
+func (s *Server) SetReady(ready bool) {
+	s.ready.Store(ready)
+}
+
+// handle registers a handler accepting only the given methods (HEAD is accepted with GET)
+func (s *Server) handle(pattern string, handler http.HandlerFunc, methods ...string) {
+	allow := strings.Join(methods, ", ")
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		method := r.Method
+		if method == http.MethodHead {
+			method = http.MethodGet
+		}
+		for _, m := range methods {
+			if m == method {
+				handler(w, r)
+				return
+			}
+		}
+		w.Header().Set("Allow", allow)
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method+" is not allowed, use "+allow)
+	})
+}
+
+// ErrorBody is the body of every error response
+type ErrorBody struct {
+	Error APIError `json:"error"`
+}
+
+// APIError describes why a request failed
+type APIError struct {
+	// Code is a stable machine-readable reason (e.g. "not_found", "payload_too_large")
+	Code string `json:"code"`
+	// Message is a human-readable description
+	Message string `json:"message"`
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(v)
+}
+
+// writeError writes an error response
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, ErrorBody{Error: APIError{Code: code, Message: message}})
+}
+
+// decode reads the JSON request body into v, enforcing the body size limit.
+// On failure it writes the error response and returns false.
+func (s *Server) decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.config.MaxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+	if err == nil && decoder.More() {
+		err = errors.New("unexpected data after the JSON value")
+	}
+	if err == nil {
+		return true
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeError(w, http.StatusRequestEntityTooLarge, "payload_too_large",
+			fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit))
+		return false
+	}
+	writeError(w, http.StatusBadRequest, "bad_request", "invalid JSON body: "+err.Error())
+	return false
+}
+
+// checkBatchSize writes an error response and returns false if a batch has more than MaxBatchSize items
+func (s *Server) checkBatchSize(w http.ResponseWriter, size int) bool {
+	if size <= s.config.MaxBatchSize {
+		return true
+	}
+	writeError(w, http.StatusRequestEntityTooLarge, "batch_too_large",
+		fmt.Sprintf("batch contains %d items, the maximum is %d", size, s.config.MaxBatchSize))
+	return false
+}
+
+// locale returns the locale requested with the lang query parameter or the
+// Accept-Language header, and false if the client did not ask for one
+func locale(r *http.Request) (i18n.Locale, bool) {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		return i18n.ParseLocale(lang), true
+	}
+	if lang := r.Header.Get("Accept-Language"); lang != "" {
+		return i18n.ParseLocale(lang), true
+	}
+	return "", false
+}
+
+// message returns the message of err, localized if the client asked for a language
+func message(r *http.Request, err error) string {
+	if loc, ok := locale(r); ok && validators.AsValidationError(err) != nil {
+		return i18n.Message(err, loc)
+	}
+	return err.Error()
+}
+
+// pathValue returns the part of the URL path after prefix
+func pathValue(r *http.Request, prefix string) string {
+	return strings.TrimPrefix(r.URL.Path, prefix)
+}
+
+// HealthResponse is the body of /healthz and /readyz
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, HealthResponse{Status: "ok"})
+}
+
+func (s *Server) handleReady(w http.ResponseWriter, _ *http.Request) {
+	if !s.ready.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, HealthResponse{Status: "unavailable"})
+		return
+	}
+	writeJSON(w, http.StatusOK, HealthResponse{Status: "ok"})
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(openAPI())
+}
+
+// ValidateRequest is the body of POST /v1/validate/{kind}
+type ValidateRequest struct {
+	// Value is the value to validate
+	Value string `json:"value"`
+	// Strict enables strict validation (no normalization, exact spacing)
+	Strict bool `json:"strict,omitempty"`
+	// Params holds validator-specific settings (e.g. "type": "special" for car plates)
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// ValidateResponse is the outcome of validating one value
+type ValidateResponse struct {
+	// Kind is the name of the validator
+	Kind string `json:"kind"`
+	// Value is the validated value
+	Value string `json:"value"`
+	types.FieldReport
+	// Message is the localized error message, set when the client asked for a language
+	Message string `json:"message,omitempty"`
+}
+
+// handleValidate serves /v1/validate/{kind}. With GET, the value is read from
+// the value query parameter, strict from the strict parameter and every other
+// parameter except lang is passed to the validator.
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	kind := pathValue(r, "/v1/validate/")
+	if alias, ok := kindAliases[strings.ToLower(kind)]; ok {
+		kind = alias
+	}
+	v, ok := s.config.Registry.Lookup(kind)
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown_validator", fmt.Sprintf("no validator named %q", kind))
+		return
+	}
+
+	var req ValidateRequest
+	if r.Method == http.MethodPost {
+		if !s.decode(w, r, &req) {
+			return
+		}
+	} else {
+		query := r.URL.Query()
+		req.Value = query.Get("value")
+		if strict := query.Get("strict"); strict != "" {
+			parsed, err := strconv.ParseBool(strict)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid strict parameter %q", strict))
+				return
+			}
+			req.Strict = parsed
+		}
+		for key := range query {
+			if key != "value" && key != "strict" && key != "lang" {
+				if req.Params == nil {
+					req.Params = make(map[string]string)
+				}
+				req.Params[key] = query.Get(key)
+			}
+		}
+	}
+
+	name := v.Name()
+	opts := validators.Options{Strict: req.Strict, Params: req.Params}
+	report := s.config.Registry.Report(map[string]string{name: req.Value}, map[string]validators.Options{name: opts})
+	response := ValidateResponse{Kind: name, Value: req.Value, FieldReport: report.Fields[name]}
+	if loc, ok := locale(r); ok && response.Error != nil {
+		response.Message = i18n.Message(response.Error, loc)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// FormatRequest is the body of POST /v1/format/{kind}
+type FormatRequest struct {
+	// Value is the value to format
+	Value string `json:"value"`
+	// Style is one of the styles of the kind (see /v1/formats); empty selects the default style
+	Style string `json:"style,omitempty"`
+}
+
+// FormatResponse is the outcome of formatting one value
+type FormatResponse struct {
+	Kind      string `json:"kind"`
+	Style     string `json:"style"`
+	Value     string `json:"value"`
+	Formatted string `json:"formatted"`
+}
+
+// handleFormat serves /v1/format/{kind}
+func (s *Server) handleFormat(w http.ResponseWriter, r *http.Request) {
+	kind := strings.ToLower(pathValue(r, "/v1/format/"))
+	if _, ok := formatters.Styles[kind]; !ok {
+		writeError(w, http.StatusNotFound, "unknown_format", fmt.Sprintf("no formatter named %q", kind))
+		return
+	}
+
+	var req FormatRequest
+	if r.Method == http.MethodPost {
+		if !s.decode(w, r, &req) {
+			return
+		}
+	} else {
+		req.Value = r.URL.Query().Get("value")
+		req.Style = r.URL.Query().Get("style")
+	}
+
+	style, ok := formatStyle(w, kind, req.Style)
+	if !ok {
+		return
+	}
+	formatted, err := formatters.Format(kind, style, req.Value)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_value", message(r, err))
+		return
+	}
+	writeJSON(w, http.StatusOK, FormatResponse{Kind: kind, Style: style, Value: req.Value, Formatted: formatted})
+}
+
+// formatStyle resolves the default style of kind and checks that style exists.
+// On failure it writes the error response and returns false.
+func formatStyle(w http.ResponseWriter, kind, style string) (string, bool) {
+	if style == "" {
+		return formatters.Styles[kind][0], true
+	}
+	if _, err := formatters.Format(kind, style, ""); errors.Is(err, formatters.ErrUnknownStyle) {
+		writeError(w, http.StatusBadRequest, "unknown_style", err.Error())
+		return "", false
+	}
+	return style, true
+}
+
+// FieldOptions contains the options of one field of a batch
+type FieldOptions struct {
+	Strict bool              `json:"strict,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// BatchValidateRequest is the body of POST /v1/batch/validate
+type BatchValidateRequest struct {
+	// Records map field names (validator names) to values
+	Records []map[string]string `json:"records"`
+	// Options contains the options of each field, keyed by field name
+	Options map[string]FieldOptions `json:"options,omitempty"`
+}
+
+// BatchValidateResponse is the outcome of POST /v1/batch/validate
+type BatchValidateResponse struct {
+	// Results contains the report of each record, in input order
+	Results []types.ValidationReport `json:"results"`
+	// Summary aggregates the results
+	Summary validators.BatchSummary `json:"summary"`
+}
+
+// handleBatchValidate serves POST /v1/batch/validate
+func (s *Server) handleBatchValidate(w http.ResponseWriter, r *http.Request) {
+	var req BatchValidateRequest
+	if !s.decode(w, r, &req) || !s.checkBatchSize(w, len(req.Records)) {
+		return
+	}
+
+	fields := make(map[string]validators.Options, len(req.Options))
+	for field, opts := range req.Options {
+		fields[field] = validators.Options{Strict: opts.Strict, Params: opts.Params}
+	}
+
+	response := BatchValidateResponse{Results: make([]types.ValidationReport, 0, len(req.Records))}
+	batch := s.config.Registry.ValidateBatch(r.Context(), req.Records, validators.BatchOptions{Ordered: true, Fields: fields})
+	for result := range batch.Results() {
+		response.Results = append(response.Results, result.Report)
+	}
+	response.Summary = batch.Wait()
+	if response.Summary.Err != nil {
+		writeError(w, http.StatusServiceUnavailable, "cancelled", response.Summary.Err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// BatchFormatRequest is the body of POST /v1/batch/format
+type BatchFormatRequest struct {
+	// Kind is phone, currency or date
+	Kind string `json:"kind"`
+	// Style is one of the styles of the kind; empty selects the default style
+	Style string `json:"style,omitempty"`
+	// Values are the values to format
+	Values []string `json:"values"`
+}
+
+// FormatResult is the outcome of formatting one value of a batch
+type FormatResult struct {
+	Value     string `json:"value"`
+	Formatted string `json:"formatted,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BatchFormatResponse is the outcome of POST /v1/batch/format
+type BatchFormatResponse struct {
+	Kind    string         `json:"kind"`
+	Style   string         `json:"style"`
+	Results []FormatResult `json:"results"`
+	// Invalid is the number of values that could not be formatted
+	Invalid int `json:"invalid"`
+}
+
+// handleBatchFormat serves POST /v1/batch/format
+func (s *Server) handleBatchFormat(w http.ResponseWriter, r *http.Request) {
+	var req BatchFormatRequest
+	if !s.decode(w, r, &req) || !s.checkBatchSize(w, len(req.Values)) {
+		return
+	}
+
+	kind := strings.ToLower(req.Kind)
+	if _, ok := formatters.Styles[kind]; !ok {
+		writeError(w, http.StatusBadRequest, "unknown_format", fmt.Sprintf("no formatter named %q", req.Kind))
+		return
+	}
+	style, ok := formatStyle(w, kind, req.Style)
+	if !ok {
+		return
+	}
+
+	response := BatchFormatResponse{Kind: kind, Style: style, Results: make([]FormatResult, len(req.Values))}
+	for i, value := range req.Values {
+		response.Results[i].Value = value
+		formatted, err := formatters.Format(kind, style, value)
+		if err != nil {
+			response.Results[i].Error = message(r, err)
+			response.Invalid++
+			continue
+		}
+		response.Results[i].Formatted = formatted
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// ValidatorsResponse is the body of GET /v1/validators
+type ValidatorsResponse struct {
+	Validators []string `json:"validators"`
+}
+
+func (s *Server) handleValidators(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, ValidatorsResponse{Validators: s.config.Registry.Names()})
+}
+
+// FormatsResponse is the body of GET /v1/formats
+type FormatsResponse struct {
+	// Formats lists the styles of each kind; the first style is the default
+	Formats map[string][]string `json:"formats"`
+}
+
+func (s *Server) handleFormats(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, FormatsResponse{Formats: formatters.Styles})
+}
+
+// Bank describes a bank
+type Bank struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// BanksResponse is the body of GET /v1/banks
+type BanksResponse struct {
+	Banks []Bank `json:"banks"`
+}
+
+func (s *Server) handleBanks(w http.ResponseWriter, _ *http.Request) {
+	response := BanksResponse{Banks: make([]Bank, 0, len(constants.Banks))}
+	for _, code := range sortedKeys(constants.Banks) {
+		response.Banks = append(response.Banks, Bank{Code: code, Name: constants.Banks[code].Name})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleBank serves /v1/banks/{value}, where value is a 2-digit bank code or a RIB
+func (s *Server) handleBank(w http.ResponseWriter, r *http.Request) {
+	value := pathValue(r, "/v1/banks/")
+	if code := validators.Normalize(value); len(code) == 2 {
+		if bank, ok := constants.Banks[code]; ok {
+			writeJSON(w, http.StatusOK, Bank{Code: bank.Code, Name: bank.Name})
+			return
+		}
+	} else if info := validators.GetBankFromRIB(value); info != nil {
+		writeJSON(w, http.StatusOK, Bank{Code: info.Bank.Code, Name: info.Bank.Name})
+		return
+	}
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no bank found for %q", value))
+}
+
+// Carrier describes a mobile carrier
+type Carrier struct {
+	Name     string   `json:"name"`
+	Prefixes []string `json:"prefixes"`
+	// Prefix is the prefix of the looked up phone number
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// CarriersResponse is the body of GET /v1/carriers
+type CarriersResponse struct {
+	Carriers []Carrier `json:"carriers"`
+}
+
+func (s *Server) handleCarriers(w http.ResponseWriter, _ *http.Request) {
+	response := CarriersResponse{Carriers: make([]Carrier, 0, len(constants.Carriers))}
+	for _, key := range sortedKeys(constants.Carriers) {
+		carrier := constants.Carriers[key]
+		response.Carriers = append(response.Carriers, Carrier{Name: carrier.Name, Prefixes: carrier.Prefixes})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleCarrier serves /v1/carriers/{phone}
+func (s *Server) handleCarrier(w http.ResponseWriter, r *http.Request) {
+	value := pathValue(r, "/v1/carriers/")
+	info := validators.GetCarrierInfo(value)
+	if info == nil {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no carrier found for %q", value))
+		return
+	}
+	writeJSON(w, http.StatusOK, Carrier{Name: info.Carrier.Name, Prefixes: info.Carrier.Prefixes, Prefix: info.Prefix})
+}
+
+// Governorate describes a governorate
+type Governorate struct {
+	Name       string `json:"name"`
+	PostalCode string `json:"postalCode"`
+	Region     string `json:"region"`
+}
+
+// GovernoratesResponse is the body of GET /v1/governorates
+type GovernoratesResponse struct {
+	Governorates []Governorate `json:"governorates"`
+}
+
+func (s *Server) handleGovernorates(w http.ResponseWriter, _ *http.Request) {
+	response := GovernoratesResponse{Governorates: make([]Governorate, 0, len(constants.Governorates))}
+	for _, key := range sortedKeys(constants.Governorates) {
+		g := constants.Governorates[key]
+		response.Governorates = append(response.Governorates, Governorate{Name: g.Name, PostalCode: g.PostalCode, Region: g.Region})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleGovernorate serves /v1/governorates/{value}, where value is a postal code or a governorate name
+func (s *Server) handleGovernorate(w http.ResponseWriter, r *http.Request) {
+	value := pathValue(r, "/v1/governorates/")
+	g := validators.GetGovernorateFromPostalCode(value)
+	if g == nil {
+		g = validators.GetGovernorateByName(value)
+	}
+	if g == nil {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no governorate found for %q", value))
+		return
+	}
+	writeJSON(w, http.StatusOK, Governorate{Name: g.Name, PostalCode: g.PostalCode, Region: g.Region})
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
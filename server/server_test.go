@@ -0,0 +1,249 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/i18n"
+	"github.com/degache-go/degache/schema"
+)
+
+// do sends a request to a server created with config and returns the recorded response
+func do(t *testing.T, config Config, method, target, body string, header ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	New(config).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestEndpoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		want   []string // substrings of the response body
+	}{
+		{"health", "GET", "/healthz", "", 200, []string{`"status":"ok"`}},
+		{"ready", "GET", "/readyz", "", 200, []string{`"status":"ok"`}},
+		{"validate valid", "GET", "/v1/validate/rib?value=01234567890123456789", "", 200,
+			[]string{`"kind":"rib"`, `"valid":true`, `"bank":"Banque Centrale de Tunisie"`}},
+		{"validate invalid", "GET", "/v1/validate/cin?value=22345678", "", 200,
+			[]string{`"valid":false`, `"code":"bad_prefix"`}},
+		{"validate strict", "GET", "/v1/validate/phone?value=20+123+456&strict=true", "", 200, []string{`"valid":false`}},
+		{"validate params", "GET", "/v1/validate/plate?value=RS+123+%D8%AA%D9%88%D9%86%D8%B3&type=special", "", 200,
+			[]string{`"kind":"carPlate"`, `"valid":true`}},
+		{"validate case-insensitive", "GET", "/v1/validate/TAXID?value=1234567A/P/M/000", "", 200, []string{`"kind":"taxID"`, `"valid":true`}},
+		{"validate localized", "GET", "/v1/validate/postal?value=10a0&lang=fr", "", 200,
+			[]string{`"message":"Le code postal ne doit contenir que des chiffres"`}},
+		{"validate POST", "POST", "/v1/validate/phone", `{"value":"20 123 456"}`, 200,
+			[]string{`"valid":true`, `"normalized":"+21620123456"`}},
+		{"validate unknown", "GET", "/v1/validate/iban?value=x", "", 404, []string{`"code":"unknown_validator"`}},
+		{"validate bad strict", "GET", "/v1/validate/cin?value=1&strict=maybe", "", 400, []string{`"code":"bad_request"`}},
+		{"validate bad JSON", "POST", "/v1/validate/cin", `{"value":`, 400, []string{`"code":"bad_request"`}},
+		{"validate unknown JSON field", "POST", "/v1/validate/cin", `{"val":"12345678"}`, 400, []string{`"code":"bad_request"`}},
+		{"validate method", "DELETE", "/v1/validate/cin", "", 405, []string{`"code":"method_not_allowed"`}},
+		{"format phone", "GET", "/v1/format/phone?value=20123456", "", 200,
+			[]string{`"style":"international"`, `"formatted":"+216 20 123 456"`}},
+		{"format currency", "POST", "/v1/format/currency", `{"value":"1234.5","style":"code"}`, 200,
+			[]string{`"formatted":"1.234,500 TND"`}},
+		{"format invalid", "GET", "/v1/format/phone?value=10123456", "", 422, []string{`"code":"invalid_value"`}},
+		{"format unknown style", "GET", "/v1/format/date?value=2024-01-15&style=fancy", "", 400, []string{`"code":"unknown_style"`}},
+		{"format unknown kind", "GET", "/v1/format/iban?value=x", "", 404, []string{`"code":"unknown_format"`}},
+		{"batch validate", "POST", "/v1/batch/validate",
+			`{"records":[{"cin":"12345678"},{"cin":"22345678","phone":"20 123 456"}],"options":{"phone":{"strict":true}}}`, 200,
+			[]string{`"total":2`, `"valid":1`, `"invalid":1`, `"fieldErrors":{"cin":1,"phone":1}`}},
+		{"batch validate GET", "GET", "/v1/batch/validate", "", 405, []string{`"code":"method_not_allowed"`}},
+		{"batch format", "POST", "/v1/batch/format", `{"kind":"date","style":"numeric","values":["2024-01-15","x"]}`, 200,
+			[]string{`"formatted":"15/01/2024"`, `"invalid":1`}},
+		{"batch format unknown kind", "POST", "/v1/batch/format", `{"kind":"iban","values":[]}`, 400, []string{`"code":"unknown_format"`}},
+		{"validators", "GET", "/v1/validators", "", 200, []string{`"carPlate"`, `"taxID"`}},
+		{"formats", "GET", "/v1/formats", "", 200, []string{`"phone":["international"`}},
+		{"banks", "GET", "/v1/banks", "", 200, []string{`{"code":"01","name":"Banque Centrale de Tunisie"}`}},
+		{"bank by code", "GET", "/v1/banks/20", "", 200, []string{`"name":"Amen Bank"`}},
+		{"bank by RIB", "GET", "/v1/banks/01234567890123456789", "", 200, []string{`"code":"01"`}},
+		{"bank not found", "GET", "/v1/banks/99", "", 404, []string{`"code":"not_found"`}},
+		{"carriers", "GET", "/v1/carriers", "", 200, []string{`"name":"Orange Tunisia"`}},
+		{"carrier", "GET", "/v1/carriers/+21698123456", "", 200, []string{`"name":"Tunisie Telecom"`, `"prefix":"9"`}},
+		{"carrier not found", "GET", "/v1/carriers/123", "", 404, []string{`"code":"not_found"`}},
+		{"governorates", "GET", "/v1/governorates", "", 200, []string{`"postalCode":"3000"`}},
+		{"governorate by postal code", "GET", "/v1/governorates/1000", "", 200, []string{`"name":"Tunis"`}},
+		{"governorate by name", "GET", "/v1/governorates/sfax", "", 200, []string{`"name":"Sfax"`, `"postalCode":"3000"`}},
+		{"governorate not found", "GET", "/v1/governorates/atlantis", "", 404, []string{`"code":"not_found"`}},
+		{"not found", "GET", "/v2/validate/cin", "", 404, []string{`"code":"not_found"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, Config{}, tt.method, tt.target, tt.body)
+			if rec.Code != tt.status {
+				t.Errorf("%s %s status = %d, want %d (body: %s)", tt.method, tt.target, rec.Code, tt.status, rec.Body)
+			}
+			if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+				t.Errorf("%s %s Content-Type = %q, want application/json", tt.method, tt.target, ct)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("%s %s body = %s, want it to contain %s", tt.method, tt.target, rec.Body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	rec := do(t, Config{}, "GET", "/v1/validate/cin?value=2234567", "", "Accept-Language", "ar-TN,ar;q=0.9")
+
+	var response ValidateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Message == "" || response.Message == response.Error.Message {
+		t.Errorf("message = %q, want an Arabic message", response.Message)
+	}
+}
+
+func TestBatchValidateOrder(t *testing.T) {
+	records := make([]map[string]string, 200)
+	for i := range records {
+		records[i] = map[string]string{"cin": "12345678"}
+		if i%3 == 0 {
+			records[i]["cin"] = "2"
+		}
+	}
+	body, _ := json.Marshal(BatchValidateRequest{Records: records})
+
+	rec := do(t, Config{}, "POST", "/v1/batch/validate", string(body))
+	var response BatchValidateResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Results) != len(records) {
+		t.Fatalf("got %d results, want %d", len(response.Results), len(records))
+	}
+	for i, report := range response.Results {
+		if report.Valid != (i%3 != 0) {
+			t.Errorf("result %d valid = %v, want %v", i, report.Valid, i%3 != 0)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	rec := do(t, Config{MaxBodyBytes: 64}, "POST", "/v1/validate/cin", `{"value":"`+strings.Repeat("1", 100)+`"}`)
+	if rec.Code != http.StatusRequestEntityTooLarge || !strings.Contains(rec.Body.String(), `"payload_too_large"`) {
+		t.Errorf("oversized body: status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	rec = do(t, Config{MaxBatchSize: 2}, "POST", "/v1/batch/format", `{"kind":"phone","values":["1","2","3"]}`)
+	if rec.Code != http.StatusRequestEntityTooLarge || !strings.Contains(rec.Body.String(), `"batch_too_large"`) {
+		t.Errorf("oversized batch: status = %d, body = %s", rec.Code, rec.Body)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	srv := New(Config{})
+	srv.SetReady(false)
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("readyz status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("healthz status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+// TestOpenAPIDocumentsEveryEndpoint checks that the embedded document is valid
+// JSON and that every documented path is served
+func TestOpenAPIDocumentsEveryEndpoint(t *testing.T) {
+	rec := do(t, Config{}, "GET", "/openapi.json", "")
+
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		t.Errorf("openapi = %q, want 3.x", doc.OpenAPI)
+	}
+
+	examples := map[string]string{
+		"/v1/validate/{kind}":      "/v1/validate/phone",
+		"/v1/format/{kind}":        "/v1/format/phone",
+		"/v1/banks/{value}":        "/v1/banks/01",
+		"/v1/carriers/{phone}":     "/v1/carriers/20123456",
+		"/v1/governorates/{value}": "/v1/governorates/1000",
+	}
+	for path, operations := range doc.Paths {
+		target := path
+		if example, ok := examples[path]; ok {
+			target = example
+		}
+		for method := range operations {
+			rec := do(t, Config{}, strings.ToUpper(method), target, "{}")
+			if rec.Code == http.StatusNotFound || rec.Code == http.StatusMethodNotAllowed {
+				t.Errorf("%s %s: status %d, documented but not served", strings.ToUpper(method), path, rec.Code)
+			}
+		}
+	}
+}
+
+// TestOpenAPIIdentifierSchemas checks that the served document holds the
+// identifier schemas generated by package schema, and that the hand-written
+// part does not define them
+func TestOpenAPIIdentifierSchemas(t *testing.T) {
+	type document struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+
+	var served, generated, source document
+	if err := json.Unmarshal(do(t, Config{}, "GET", "/openapi.json", "").Body.Bytes(), &served); err != nil {
+		t.Fatal(err)
+	}
+	data, err := schema.OpenAPI(i18n.English)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &generated); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(openAPISource, &source); err != nil {
+		t.Fatal(err)
+	}
+
+	if served.OpenAPI != generated.OpenAPI {
+		t.Errorf("openapi = %q, want %q", served.OpenAPI, generated.OpenAPI)
+	}
+	for name, want := range generated.Components.Schemas {
+		if _, ok := source.Components.Schemas[name]; ok {
+			t.Errorf("openapi.json defines %q, which is generated", name)
+		}
+		var got, expected any
+		if err := json.Unmarshal(served.Components.Schemas[name], &got); err != nil {
+			t.Errorf("components.schemas.%s: %v", name, err)
+			continue
+		}
+		_ = json.Unmarshal(want, &expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("components.schemas.%s = %s, want %s", name, served.Components.Schemas[name], want)
+		}
+	}
+}
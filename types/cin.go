@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/normalize"
+)
+
+// ParseCIN parses a CIN after normalizing it (see package normalize)
+//
+// Parameters:
+//   - s: The CIN to parse
+//
+// Returns:
+//   - CIN: the validated CIN
+//   - error: a *ValidationError if the CIN is invalid
+//
+// Example:
+//
+//	cin, err := ParseCIN(" ١٢٣٤٥٦٧٨ ")
+//	// Returns: "12345678", nil
+func ParseCIN(s string) (CIN, error) {
+	cin := CIN(normalize.String(s))
+	if err := cin.Validate(); err != nil {
+		return "", err
+	}
+	return cin, nil
+}
+
+// Validate checks that the CIN is 8 digits starting with 0 or 1
+//
+// Returns:
+//   - error: nil if valid, otherwise a *ValidationError
+func (c CIN) Validate() error {
+	if c == "" {
+		return newValidationError("cin", ErrEmpty, -1, "8 digits", "CIN cannot be empty")
+	}
+
+	if len(c) != 8 {
+		return lengthError("cin", len(c), 8, "8 digits", "CIN must be exactly 8 digits")
+	}
+
+	const formatMsg = "CIN must start with 0 or 1 and contain only digits"
+	if c[0] != '0' && c[0] != '1' {
+		return newValidationError("cin", ErrBadPrefix, 0, "0 or 1", formatMsg)
+	}
+
+	if i := scan.FirstNonDigit(string(c)); i >= 0 {
+		return newValidationError("cin", ErrInvalidCharacter, i, "digit", formatMsg)
+	}
+
+	return nil
+}
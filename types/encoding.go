@@ -0,0 +1,151 @@
+package types
+
+// PhoneNumberFormat selects how PhoneNumber.Formatted writes a phone number
+type PhoneNumberFormat int
+
+const (
+	// PhoneFormatNational writes the 8 national digits (e.g. "20123456")
+	PhoneFormatNational PhoneNumberFormat = iota
+	// PhoneFormatE164 writes the number in E.164 format (e.g. "+21620123456")
+	PhoneFormatE164
+)
+
+// E164PhoneNumber is a PhoneNumber written in E.164 format by MarshalText
+// (e.g. "+21620123456"). Use it for the fields of documents whose consumers
+// expect international numbers; it is stored in databases in the same
+// national form as PhoneNumber.
+//
+// Example:
+//
+//	type Contact struct {
+//	    Phone types.E164PhoneNumber `json:"phone"`
+//	}
+type E164PhoneNumber PhoneNumber
+
+// The Tunisian identifier types implement encoding.TextMarshaler and
+// encoding.TextUnmarshaler, which encoding/json, encoding/xml and most
+// configuration libraries use. Unmarshaling validates and normalizes the
+// value with the matching Parse function; marshaling always writes the
+// canonical form. An empty value is accepted and kept empty so that optional
+// fields round-trip; use validators.ValidateStruct to require them.
+
+// MarshalText implements encoding.TextMarshaler
+func (c CIN) MarshalText() ([]byte, error) {
+	return marshalCanonical(c, ParseCIN)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (c *CIN) UnmarshalText(text []byte) error {
+	return unmarshalCanonical(c, text, ParseCIN)
+}
+
+// MarshalText implements encoding.TextMarshaler with the 8 national digits
+func (p PhoneNumber) MarshalText() ([]byte, error) {
+	return marshalCanonical(p, parsePhoneNumber)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The number is stored in national form (e.g. "+216 20 123 456" becomes "20123456").
+func (p *PhoneNumber) UnmarshalText(text []byte) error {
+	return unmarshalCanonical(p, text, parsePhoneNumber)
+}
+
+// MarshalText implements encoding.TextMarshaler in E.164 format
+func (p E164PhoneNumber) MarshalText() ([]byte, error) {
+	if p == "" {
+		return []byte{}, nil
+	}
+
+	phone, err := ParsePhoneNumber(string(p))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(phone.E164()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The number is stored in national form, like PhoneNumber.
+func (p *E164PhoneNumber) UnmarshalText(text []byte) error {
+	return (*PhoneNumber)(p).UnmarshalText(text)
+}
+
+// Formatted returns the phone number in the given format,
+// or an empty string if the number is invalid
+//
+// Example:
+//
+//	PhoneNumber("20 123 456").Formatted(PhoneFormatE164) // "+21620123456"
+func (p PhoneNumber) Formatted(format PhoneNumberFormat) string {
+	if format == PhoneFormatE164 {
+		return p.E164()
+	}
+
+	national, ok := p.national()
+	if !ok {
+		return ""
+	}
+	return national
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t TaxID) MarshalText() ([]byte, error) {
+	return marshalCanonical(t, ParseTaxID)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *TaxID) UnmarshalText(text []byte) error {
+	return unmarshalCanonical(t, text, ParseTaxID)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (r RIB) MarshalText() ([]byte, error) {
+	return marshalCanonical(r, ParseRIB)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (r *RIB) UnmarshalText(text []byte) error {
+	return unmarshalCanonical(r, text, ParseRIB)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (p PostalCode) MarshalText() ([]byte, error) {
+	return marshalCanonical(p, ParsePostalCode)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *PostalCode) UnmarshalText(text []byte) error {
+	return unmarshalCanonical(p, text, ParsePostalCode)
+}
+
+// parsePhoneNumber adapts ParsePhoneNumber to the signature used by the helpers
+func parsePhoneNumber(s string) (PhoneNumber, error) {
+	return ParsePhoneNumber(s)
+}
+
+// marshalCanonical writes the canonical form of v, or nothing for an empty value
+func marshalCanonical[T ~string](v T, parse func(string) (T, error)) ([]byte, error) {
+	if v == "" {
+		return []byte{}, nil
+	}
+
+	canonical, err := parse(string(v))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(canonical), nil
+}
+
+// unmarshalCanonical parses text into v, keeping an empty text as the zero value
+func unmarshalCanonical[T ~string](v *T, text []byte, parse func(string) (T, error)) error {
+	if len(text) == 0 {
+		*v = ""
+		return nil
+	}
+
+	parsed, err := parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type customer struct {
+	CIN    CIN         `json:"cin"`
+	Phone  PhoneNumber `json:"phone"`
+	TaxID  TaxID       `json:"taxId,omitempty"`
+	RIB    RIB         `json:"rib"`
+	Postal PostalCode  `json:"postal"`
+}
+
+func TestJSONDecodingNormalizes(t *testing.T) {
+	payload := `{
+		"cin": "12345678",
+		"phone": "+216 20 123 456",
+		"rib": "01 234 5678901234567 89",
+		"postal": " 3000 "
+	}`
+
+	var c customer
+	if err := json.Unmarshal([]byte(payload), &c); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+
+	want := customer{CIN: "12345678", Phone: "20123456", RIB: "01234567890123456789", Postal: "3000"}
+	if c != want {
+		t.Errorf("decoded = %+v, want %+v", c, want)
+	}
+
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+	expected := `{"cin":"12345678","phone":"20123456","rib":"01234567890123456789","postal":"3000"}`
+	if string(encoded) != expected {
+		t.Errorf("json.Marshal() = %s, want %s", encoded, expected)
+	}
+}
+
+func TestJSONDecodingRejectsInvalid(t *testing.T) {
+	var c customer
+	err := json.Unmarshal([]byte(`{"cin": "22345678"}`), &c)
+	if !errors.Is(err, ErrBadPrefix) {
+		t.Errorf("json.Unmarshal() error = %v, want %q", err, ErrBadPrefix)
+	}
+
+	err = json.Unmarshal([]byte(`{"taxId": "1234567A-P-M-000"}`), &c)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Field != "taxID" {
+		t.Errorf("json.Unmarshal() error = %v, want a taxID *ValidationError", err)
+	}
+}
+
+func TestPhoneNumberOutputFormat(t *testing.T) {
+	phone := PhoneNumber("20 123 456")
+	if got := phone.Formatted(PhoneFormatE164); got != "+21620123456" {
+		t.Errorf("Formatted(PhoneFormatE164) = %q, want +21620123456", got)
+	}
+	if got := phone.Formatted(PhoneFormatNational); got != "20123456" {
+		t.Errorf("Formatted(PhoneFormatNational) = %q, want 20123456", got)
+	}
+
+	var contact struct {
+		Phone    E164PhoneNumber `json:"phone"`
+		Landline PhoneNumber     `json:"landline"`
+	}
+	if err := json.Unmarshal([]byte(`{"phone": "+216 20 123 456", "landline": "98 123 456"}`), &contact); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+	if contact.Phone != "20123456" {
+		t.Errorf("decoded E164PhoneNumber = %q, want 20123456", string(contact.Phone))
+	}
+	encoded, err := json.Marshal(contact)
+	if want := `{"phone":"+21620123456","landline":"98123456"}`; err != nil || string(encoded) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", encoded, err, want)
+	}
+
+	if _, err := PhoneNumber("10123456").MarshalText(); err == nil {
+		t.Error("MarshalText() of an invalid number should fail")
+	}
+	if _, err := E164PhoneNumber("10123456").MarshalText(); err == nil {
+		t.Error("E164PhoneNumber.MarshalText() of an invalid number should fail")
+	}
+}
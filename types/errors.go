@@ -0,0 +1,91 @@
+package types
+
+import "fmt"
+
+// ErrorCode is a stable, machine-readable identifier for a validation failure.
+// ErrorCode implements error so that it can be used as a target with errors.Is:
+//
+//	if errors.Is(err, types.ErrTooShort) { ... }
+type ErrorCode string
+
+// Validation error codes shared by every validator
+const (
+	// ErrEmpty is returned when the input is empty
+	ErrEmpty ErrorCode = "empty"
+	// ErrTooShort is returned when the input has fewer characters than required
+	ErrTooShort ErrorCode = "too_short"
+	// ErrTooLong is returned when the input has more characters than allowed
+	ErrTooLong ErrorCode = "too_long"
+	// ErrInvalidCharacter is returned when a character is not allowed at its position
+	ErrInvalidCharacter ErrorCode = "invalid_character"
+	// ErrBadPrefix is returned when the leading part of the input is not allowed
+	ErrBadPrefix ErrorCode = "bad_prefix"
+	// ErrUnknownBank is returned when a RIB bank code is not a known Tunisian bank
+	ErrUnknownBank ErrorCode = "unknown_bank"
+	// ErrChecksum is returned when a control key does not match
+	ErrChecksum ErrorCode = "checksum"
+	// ErrBadFormat is returned when the input does not follow the expected layout
+	ErrBadFormat ErrorCode = "bad_format"
+	// ErrUnsupportedType is returned when validation options request an unknown variant
+	ErrUnsupportedType ErrorCode = "unsupported_type"
+	// ErrUnknownField is reported when no validator is registered for a field
+	ErrUnknownField ErrorCode = "unknown_field"
+	// ErrInvalid is reported for failures that carry no more specific code
+	ErrInvalid ErrorCode = "invalid"
+)
+
+// Error implements the error interface
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+// ValidationError describes why a value failed validation
+type ValidationError struct {
+	// Code is the stable machine-readable reason
+	Code ErrorCode `json:"code"`
+	// Field is the kind of value that was validated (cin, phone, taxID, rib, postal, carPlate)
+	Field string `json:"field"`
+	// Position is the byte offset of the offending character in the input,
+	// or -1 when the failure is not tied to a single character
+	Position int `json:"position"`
+	// Expected describes what was expected at Position (or overall)
+	Expected string `json:"expected,omitempty"`
+	// Message is a human-readable English description
+	Message string `json:"message"`
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Position >= 0 {
+		return fmt.Sprintf("%s: %s at position %d (expected %s)", e.Field, e.Code, e.Position, e.Expected)
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Code)
+}
+
+// Unwrap returns the error code so that errors.Is matches on it
+func (e *ValidationError) Unwrap() error {
+	return e.Code
+}
+
+// newValidationError builds a *ValidationError for the given field
+func newValidationError(field string, code ErrorCode, position int, expected, message string) *ValidationError {
+	return &ValidationError{
+		Code:     code,
+		Field:    field,
+		Position: position,
+		Expected: expected,
+		Message:  message,
+	}
+}
+
+// lengthError returns ErrTooShort or ErrTooLong depending on the actual length
+func lengthError(field string, actual, want int, expected, message string) *ValidationError {
+	code := ErrTooLong
+	if actual < want {
+		code = ErrTooShort
+	}
+	return newValidationError(field, code, -1, expected, message)
+}
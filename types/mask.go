@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/degache-go/degache/constants"
+)
+
+// MaskPolicy selects the characters of an identifier that stay visible when
+// it is masked
+type MaskPolicy struct {
+	// Prefix is the number of leading digits left visible
+	Prefix int `json:"prefix"`
+	// Suffix is the number of trailing digits left visible
+	Suffix int `json:"suffix"`
+	// Char replaces the hidden digits; '*' if zero
+	Char rune `json:"char,omitempty"`
+}
+
+// Default mask policies, used by the Mask methods without a policy, by
+// slog (LogValue) and by fmt. They should be set once during program initialization.
+var (
+	// CINMaskPolicy shows the first and last 2 digits: "12****78"
+	CINMaskPolicy = MaskPolicy{Prefix: 2, Suffix: 2}
+	// PhoneNumberMaskPolicy shows the carrier prefix and the last 3 digits: "+216 20 *** 456"
+	PhoneNumberMaskPolicy = MaskPolicy{Prefix: 2, Suffix: 3}
+	// RIBMaskPolicy shows the bank code and the key: "01****************89"
+	RIBMaskPolicy = MaskPolicy{Prefix: 2, Suffix: 2}
+	// TaxIDMaskPolicy applies to the 7-digit number and shows its last 3 digits;
+	// the key, codes and establishment number stay visible: "****567A/P/M/000"
+	TaxIDMaskPolicy = MaskPolicy{Prefix: 0, Suffix: 3}
+)
+
+// The identifier types, E164PhoneNumber included, implement slog.LogValuer and
+// fmt.Formatter with their default mask policy, so they are masked in
+// formatted output and when logged as attributes of their own.
+// Converting a value to string reveals it: slog.String("cin", string(cin)).
+//
+// slog only calls LogValue on attribute values, not on values nested in
+// them: an identifier inside a struct, slice or map attribute is printed by
+// the handler, which the JSON handler does through MarshalText, in full. Log
+// such identifiers as attributes of their own, in a slog.Group if needed, or
+// mask them with Mask first.
+
+// char returns the replacement character
+func (p MaskPolicy) char() string {
+	if p.Char == 0 {
+		return "*"
+	}
+	return string(p.Char)
+}
+
+// apply masks the digits of s, which must be ASCII, according to the policy
+func (p MaskPolicy) apply(s string) string {
+	prefix := min(max(p.Prefix, 0), len(s))
+	suffix := min(max(p.Suffix, 0), len(s)-prefix)
+	return s[:prefix] + strings.Repeat(p.char(), len(s)-prefix-suffix) + s[len(s)-suffix:]
+}
+
+// policyOr returns the first policy or the default one
+func policyOr(policies []MaskPolicy, def MaskPolicy) MaskPolicy {
+	if len(policies) > 0 {
+		return policies[0]
+	}
+	return def
+}
+
+// maskAll hides every character of an invalid value, keeping its length
+func maskAll(s string, policies []MaskPolicy) string {
+	return strings.Repeat(policyOr(policies, MaskPolicy{}).char(), utf8.RuneCountInString(s))
+}
+
+// Mask returns the CIN with hidden digits; an invalid CIN is hidden entirely
+//
+// Parameters:
+//   - policy: The mask policy (optional, CINMaskPolicy by default)
+//
+// Example:
+//
+//	CIN("12345678").Mask() // "12****78"
+func (c CIN) Mask(policy ...MaskPolicy) string {
+	if c.Validate() != nil {
+		return maskAll(string(c), policy)
+	}
+	return policyOr(policy, CINMaskPolicy).apply(string(c))
+}
+
+// Mask returns the phone number in spaced international format with hidden
+// digits; an invalid number is hidden entirely
+//
+// Parameters:
+//   - policy: The mask policy, applied to the 8 national digits (optional, PhoneNumberMaskPolicy by default)
+//
+// Example:
+//
+//	PhoneNumber("20123456").Mask() // "+216 20 *** 456"
+func (p PhoneNumber) Mask(policy ...MaskPolicy) string {
+	national, ok := p.national()
+	if !ok {
+		return maskAll(string(p), policy)
+	}
+	s := []rune(policyOr(policy, PhoneNumberMaskPolicy).apply(national))
+	return constants.CountryCode + " " + string(s[:2]) + " " + string(s[2:5]) + " " + string(s[5:])
+}
+
+// Mask returns the RIB with hidden digits; an invalid RIB is hidden entirely
+//
+// Parameters:
+//   - policy: The mask policy (optional, RIBMaskPolicy by default)
+//
+// Example:
+//
+//	RIB("01234567890123456789").Mask() // "01****************89"
+func (r RIB) Mask(policy ...MaskPolicy) string {
+	if r.Validate() != nil {
+		return maskAll(string(r), policy)
+	}
+	return policyOr(policy, RIBMaskPolicy).apply(string(r))
+}
+
+// Mask returns the Tax ID with hidden digits in its 7-digit number; an invalid
+// Tax ID is hidden entirely
+//
+// Parameters:
+//   - policy: The mask policy, applied to the number (optional, TaxIDMaskPolicy by default)
+//
+// Example:
+//
+//	TaxID("1234567A/P/M/000").Mask() // "****567A/P/M/000"
+func (t TaxID) Mask(policy ...MaskPolicy) string {
+	if t.Validate() != nil {
+		return maskAll(string(t), policy)
+	}
+	return policyOr(policy, TaxIDMaskPolicy).apply(string(t[:7])) + string(t[7:])
+}
+
+// LogValue implements slog.LogValuer with the masked CIN
+func (c CIN) LogValue() slog.Value { return slog.StringValue(c.Mask()) }
+
+// LogValue implements slog.LogValuer with the masked phone number
+func (p PhoneNumber) LogValue() slog.Value { return slog.StringValue(p.Mask()) }
+
+// LogValue implements slog.LogValuer with the masked phone number
+func (p E164PhoneNumber) LogValue() slog.Value { return PhoneNumber(p).LogValue() }
+
+// LogValue implements slog.LogValuer with the masked RIB
+func (r RIB) LogValue() slog.Value { return slog.StringValue(r.Mask()) }
+
+// LogValue implements slog.LogValuer with the masked Tax ID
+func (t TaxID) LogValue() slog.Value { return slog.StringValue(t.Mask()) }
+
+// Format implements fmt.Formatter: every verb formats the masked CIN
+func (c CIN) Format(f fmt.State, verb rune) { formatMasked(f, verb, c.Mask()) }
+
+// Format implements fmt.Formatter: every verb formats the masked phone number
+func (p PhoneNumber) Format(f fmt.State, verb rune) { formatMasked(f, verb, p.Mask()) }
+
+// Format implements fmt.Formatter: every verb formats the masked phone number
+func (p E164PhoneNumber) Format(f fmt.State, verb rune) { PhoneNumber(p).Format(f, verb) }
+
+// Format implements fmt.Formatter: every verb formats the masked RIB
+func (r RIB) Format(f fmt.State, verb rune) { formatMasked(f, verb, r.Mask()) }
+
+// Format implements fmt.Formatter: every verb formats the masked Tax ID
+func (t TaxID) Format(f fmt.State, verb rune) { formatMasked(f, verb, t.Mask()) }
+
+// formatMasked formats a masked value as a string, keeping the flags, width and precision
+func formatMasked(f fmt.State, verb rune, masked string) {
+	if verb != 'q' && verb != 'x' && verb != 'X' {
+		verb = 's'
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), masked)
+}
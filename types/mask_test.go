@@ -0,0 +1,109 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"CIN", CIN("12345678").Mask(), "12****78"},
+		{"CIN custom policy", CIN("12345678").Mask(MaskPolicy{Suffix: 3, Char: 'x'}), "xxxxx678"},
+		{"CIN policy larger than value", CIN("12345678").Mask(MaskPolicy{Prefix: 6, Suffix: 6}), "12345678"},
+		{"CIN invalid", CIN("2234567").Mask(), "*******"},
+		{"Phone", PhoneNumber("20123456").Mask(), "+216 20 *** 456"},
+		{"Phone international", PhoneNumber("+216 98 123 456").Mask(), "+216 98 *** 456"},
+		{"Phone custom policy", PhoneNumber("20123456").Mask(MaskPolicy{Char: '#'}), "+216 ## ### ###"},
+		{"Phone invalid", PhoneNumber("10123456").Mask(), "********"},
+		{"RIB", RIB("01234567890123456789").Mask(), "01****************89"},
+		{"RIB custom policy", RIB("01234567890123456789").Mask(MaskPolicy{Prefix: 5, Suffix: 2}), "01234*************89"},
+		{"RIB invalid", RIB("99").Mask(), "**"},
+		{"Tax ID", TaxID("1234567A/P/M/000").Mask(), "****567A/P/M/000"},
+		{"Tax ID custom policy", TaxID("1234567A/P/M/000").Mask(MaskPolicy{Prefix: 1}), "1******A/P/M/000"},
+		{"Tax ID invalid", TaxID("1234567").Mask(), "*******"},
+		{"Empty", CIN("").Mask(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Mask() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskedFormatting(t *testing.T) {
+	cin, phone, rib, taxID := CIN("12345678"), PhoneNumber("20123456"), RIB("01234567890123456789"), TaxID("1234567A/P/M/000")
+
+	tests := []struct {
+		format string
+		args   []any
+		want   string
+	}{
+		{"%v", []any{cin}, "12****78"},
+		{"%s", []any{cin}, "12****78"},
+		{"%d", []any{cin}, "12****78"},
+		{"%q", []any{cin}, `"12****78"`},
+		{"%10s|", []any{cin}, "  12****78|"},
+		{"%-10v|", []any{cin}, "12****78  |"},
+		{"%#v", []any{cin}, "12****78"},
+		{"%v", []any{phone}, "+216 20 *** 456"},
+		{"%d", []any{phone}, "+216 20 *** 456"},
+		{"%v", []any{E164PhoneNumber(phone)}, "+216 20 *** 456"},
+		{"%#v", []any{phone}, "+216 20 *** 456"},
+		{"%#v", []any{struct{ P PhoneNumber }{phone}}, "struct { P types.PhoneNumber }{P:+216 20 *** 456}"},
+		{"%s", []any{rib}, "01****************89"},
+		{"%v", []any{taxID}, "****567A/P/M/000"},
+		{"%+v", []any{struct {
+			CIN   CIN
+			Phone PhoneNumber
+		}{cin, phone}}, "{CIN:12****78 Phone:+216 20 *** 456}"},
+		{"%v", []any{[]RIB{rib}}, "[01****************89]"},
+		{"%s", []any{string(cin)}, "12345678"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.args...); got != tt.want {
+			t.Errorf("Sprintf(%q, %T) = %q, want %q", tt.format, tt.args[0], got, tt.want)
+		}
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("customer",
+		"cin", CIN("12345678"),
+		"phone", PhoneNumber("20123456"),
+		"rib", RIB("01234567890123456789"),
+		"taxID", TaxID("1234567A/P/M/000"),
+		"revealed", string(CIN("01234567")),
+		slog.Group("customer", "cin", CIN("12345678")))
+
+	for _, want := range []string{
+		`"cin":"12****78"`,
+		`"phone":"+216 20 *** 456"`,
+		`"rib":"01****************89"`,
+		`"taxID":"****567A/P/M/000"`,
+		`"revealed":"01234567"`,
+		`"customer":{"cin":"12****78"}`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("log output %s does not contain %s", buf.String(), want)
+		}
+	}
+	for _, secret := range []string{"12345678", "20123456", "01234567890123456789"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("log output %s reveals %s", buf.String(), secret)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		parse    func(string) (string, error)
+		input    string
+		expected string
+		code     ErrorCode
+	}{
+		{"CIN", parseString(ParseCIN), " 12345678 ", "12345678", ""},
+		{"CIN bad prefix", parseString(ParseCIN), "22345678", "", ErrBadPrefix},
+		{"Phone with prefix and spaces", parseString(func(s string) (PhoneNumber, error) { return ParsePhoneNumber(s) }),
+			"+216 20 123 456", "20123456", ""},
+		{"Phone strict", parseString(func(s string) (PhoneNumber, error) {
+			return ParsePhoneNumber(s, PhoneNumberValidationOptions{Strict: true})
+		}), "20 123 456", "", ErrBadFormat},
+		{"Phone bad prefix", parseString(func(s string) (PhoneNumber, error) { return ParsePhoneNumber(s) }),
+			"10123456", "", ErrBadPrefix},
+		{"Tax ID lower case", parseString(ParseTaxID), "1234567a/p/m/000", "1234567A/P/M/000", ""},
+		{"Tax ID bad separator", parseString(ParseTaxID), "1234567A-P-M-000", "", ErrBadFormat},
+		{"RIB grouped", parseString(ParseRIB), "01 234 5678901234567 89", "01234567890123456789", ""},
+		{"RIB unknown bank", parseString(ParseRIB), "99234567890123456789", "", ErrUnknownBank},
+		{"Postal code", parseString(ParsePostalCode), "3000", "3000", ""},
+		{"Postal code short", parseString(ParsePostalCode), "300", "", ErrTooShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.parse(tt.input)
+			if tt.code != "" {
+				if !errors.Is(err, tt.code) {
+					t.Errorf("parse(%q) error = %v, want %q", tt.input, err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("parse(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTypeMethods(t *testing.T) {
+	phone := PhoneNumber("20123456")
+	if phone.E164() != "+21620123456" {
+		t.Errorf("E164() = %q, want +21620123456", phone.E164())
+	}
+	if phone.National() != "20 123 456" {
+		t.Errorf("National() = %q, want 20 123 456", phone.National())
+	}
+	if carrier := phone.Carrier(); carrier == nil || carrier.Carrier.Name != "Ooredoo Tunisia" {
+		t.Errorf("Carrier() = %+v, want Ooredoo Tunisia", carrier)
+	}
+	if PhoneNumber("10123456").Carrier() != nil {
+		t.Error("Carrier() of an invalid number should be nil")
+	}
+
+	rib := RIB("01234567890123456789")
+	if bank := rib.Bank(); bank == nil || bank.Code != "01" {
+		t.Errorf("Bank() = %+v, want code 01", bank)
+	}
+	want := RIBComponents{BankCode: "01", BranchCode: "234", AccountNumber: "5678901234567", Key: "89"}
+	if rib.Components() != want {
+		t.Errorf("Components() = %+v, want %+v", rib.Components(), want)
+	}
+
+	taxID := TaxID("1234567A/P/M/000")
+	wantTax := TaxIDComponents{Number: "1234567", Type1: "A", Type2: "P", Type3: "M", Sequence: "000"}
+	if taxID.Components() != wantTax {
+		t.Errorf("Components() = %+v, want %+v", taxID.Components(), wantTax)
+	}
+
+	if governorate := PostalCode("3000").Governorate(); governorate == nil || governorate.Name != "Sfax" {
+		t.Errorf("Governorate() = %+v, want Sfax", governorate)
+	}
+	if PostalCode("3001").Governorate() != nil {
+		t.Error("Governorate() of a sub-region code should be nil")
+	}
+}
+
+// parseString adapts a typed parse function to return a plain string
+func parseString[T ~string](parse func(string) (T, error)) func(string) (string, error) {
+	return func(s string) (string, error) {
+		v, err := parse(s)
+		return string(v), err
+	}
+}
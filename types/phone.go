@@ -0,0 +1,199 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/normalize"
+)
+
+// ParsePhoneNumber parses a phone number and returns its 8-digit national form
+//
+// Parameters:
+//   - s: The phone number to parse (e.g. "+216 20 123 456")
+//   - options: Validation options (optional)
+//
+// Returns:
+//   - PhoneNumber: the phone number in national form (e.g. "20123456")
+//   - error: a *ValidationError if the phone number is invalid
+//
+// Example:
+//
+//	phone, err := ParsePhoneNumber("+216 20 123 456")
+//	// Returns: "20123456", nil
+func ParsePhoneNumber(s string, options ...PhoneNumberValidationOptions) (PhoneNumber, error) {
+	var opts PhoneNumberValidationOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if err := PhoneNumber(s).Validate(opts); err != nil {
+		return "", err
+	}
+	return PhoneNumber(nationalDigits(s, opts.Strict)), nil
+}
+
+// Validate checks that the phone number is a valid Tunisian number.
+// Outside strict mode, the input is normalized (see package normalize), spaces
+// and separators are ignored and a +216 prefix is accepted. Positions of
+// errors then refer to the normalized input.
+//
+// Parameters:
+//   - options: Validation options (optional)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *ValidationError
+func (p PhoneNumber) Validate(options ...PhoneNumberValidationOptions) error {
+	if p == "" {
+		return newValidationError("phone", ErrEmpty, -1, "8 digits", "Phone number cannot be empty")
+	}
+
+	var opts PhoneNumberValidationOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	s := string(p)
+	if !opts.Strict {
+		s = normalize.String(s)
+	}
+
+	offset := 0
+	if strings.HasPrefix(s, constants.CountryCode) {
+		offset = len(constants.CountryCode)
+	}
+
+	// In strict mode, only an optional +216 followed by 8 digits is accepted
+	if opts.Strict && !isStrictPhoneNumber(s[offset:]) {
+		return newValidationError("phone", ErrBadFormat, -1, "8 digits, optionally prefixed by +216",
+			"Phone number format is invalid in strict mode")
+	}
+
+	// Remember where the first digit was so that positions refer to the input
+	if !opts.Strict {
+		for offset < len(s) && !scan.IsDigit(s[offset]) {
+			offset++
+		}
+	}
+
+	first, count := firstDigit(s[offset:])
+	if count != 8 {
+		return lengthError("phone", count, 8, "8 digits", "Phone number must be exactly 8 digits")
+	}
+
+	if first < '2' {
+		return newValidationError("phone", ErrBadPrefix, offset, "2-9",
+			"Phone number must start with 2-9 and contain only digits")
+	}
+
+	// Check if the prefix is valid
+	for _, validPrefix := range constants.ValidPrefixes {
+		if len(validPrefix) == 1 && validPrefix[0] == first {
+			return nil
+		}
+	}
+
+	return newValidationError("phone", ErrBadPrefix, offset, strings.Join(constants.ValidPrefixes, ", "),
+		"Phone number prefix is not valid for Tunisian carriers")
+}
+
+// Carrier returns the mobile carrier of the phone number
+//
+// Returns:
+//   - *CarrierInfo: carrier information or nil if the number is invalid
+//
+// Example:
+//
+//	phone, _ := ParsePhoneNumber("20123456")
+//	fmt.Println(phone.Carrier().Carrier.Name) // Ooredoo Tunisia
+func (p PhoneNumber) Carrier() *CarrierInfo {
+	national, ok := p.national()
+	if !ok {
+		return nil
+	}
+
+	prefix := national[:1]
+	for _, carrier := range constants.Carriers {
+		for _, carrierPrefix := range carrier.Prefixes {
+			if prefix == carrierPrefix {
+				return &CarrierInfo{
+					Carrier: carrier,
+					Prefix:  prefix,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// E164 returns the phone number in E.164 format (e.g. "+21620123456"),
+// or an empty string if the number is invalid
+func (p PhoneNumber) E164() string {
+	national, ok := p.national()
+	if !ok {
+		return ""
+	}
+	return constants.CountryCode + national
+}
+
+// National returns the phone number in spaced national format (e.g. "20 123 456"),
+// or an empty string if the number is invalid
+func (p PhoneNumber) National() string {
+	national, ok := p.national()
+	if !ok {
+		return ""
+	}
+	return national[:2] + " " + national[2:5] + " " + national[5:]
+}
+
+// national returns the 8 national digits of a valid phone number
+func (p PhoneNumber) national() (string, bool) {
+	if p.Validate() != nil {
+		return "", false
+	}
+	return nationalDigits(string(p), false), true
+}
+
+// nationalDigits strips the +216 prefix and, outside strict mode, normalizes
+// the input and removes every non-digit character. It only allocates when
+// digits have to be removed.
+func nationalDigits(s string, strict bool) string {
+	if strict {
+		return strings.TrimPrefix(s, constants.CountryCode)
+	}
+
+	s = strings.TrimPrefix(normalize.String(s), constants.CountryCode)
+	if _, count := firstDigit(s); count == len(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if scan.IsDigit(s[i]) {
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// firstDigit returns the first ASCII digit of s and the number of digits in s
+func firstDigit(s string) (first byte, count int) {
+	for i := 0; i < len(s); i++ {
+		if !scan.IsDigit(s[i]) {
+			continue
+		}
+		if count == 0 {
+			first = s[i]
+		}
+		count++
+	}
+	return first, count
+}
+
+// isStrictPhoneNumber reports whether s is exactly 8 digits starting with 2-9
+func isStrictPhoneNumber(s string) bool {
+	return len(s) == 8 && s[0] >= '2' && s[0] <= '9' && scan.IsDigits(s)
+}
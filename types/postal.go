@@ -0,0 +1,66 @@
+package types
+
+import (
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/normalize"
+)
+
+// ParsePostalCode parses a postal code after normalizing it (see package normalize)
+//
+// Parameters:
+//   - s: The postal code to parse
+//
+// Returns:
+//   - PostalCode: the validated postal code
+//   - error: a *ValidationError if the postal code is invalid
+func ParsePostalCode(s string) (PostalCode, error) {
+	postalCode := PostalCode(normalize.String(s))
+	if err := postalCode.Validate(); err != nil {
+		return "", err
+	}
+	return postalCode, nil
+}
+
+// Validate checks that the postal code is 4 digits
+//
+// Returns:
+//   - error: nil if valid, otherwise a *ValidationError
+func (p PostalCode) Validate() error {
+	if p == "" {
+		return newValidationError("postal", ErrEmpty, -1, "4 digits", "Postal code cannot be empty")
+	}
+
+	if len(p) != 4 {
+		return lengthError("postal", len(p), 4, "4 digits", "Postal code must be exactly 4 digits")
+	}
+
+	if i := scan.FirstNonDigit(string(p)); i >= 0 {
+		return newValidationError("postal", ErrInvalidCharacter, i, "digit", "Postal code must contain only digits")
+	}
+
+	return nil
+}
+
+// Governorate returns the governorate whose main postal code is p
+//
+// Returns:
+//   - *constants.Governorate: governorate information or nil if not found
+//
+// Example:
+//
+//	postalCode, _ := ParsePostalCode("3000")
+//	fmt.Println(postalCode.Governorate().Name) // Sfax
+func (p PostalCode) Governorate() *constants.Governorate {
+	if p.Validate() != nil {
+		return nil
+	}
+
+	for _, governorate := range constants.Governorates {
+		if governorate.PostalCode == string(p) {
+			return &governorate
+		}
+	}
+
+	return nil
+}
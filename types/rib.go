@@ -0,0 +1,108 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/normalize"
+)
+
+// RIBComponents contains the components of a RIB
+type RIBComponents struct {
+	BankCode      string
+	BranchCode    string
+	AccountNumber string
+	Key           string
+}
+
+// ParseRIB parses a RIB after normalizing it (see package normalize),
+// removing spaces and dashes used to group digits
+//
+// Parameters:
+//   - s: The RIB to parse (e.g. "01 234 5678901234567 89")
+//
+// Returns:
+//   - RIB: the validated 20-digit RIB
+//   - error: a *ValidationError if the RIB is invalid
+//
+// Example:
+//
+//	rib, err := ParseRIB("01 234 5678901234567 89")
+//	// Returns: "01234567890123456789", nil
+func ParseRIB(s string) (RIB, error) {
+	rib := RIB(strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, normalize.String(s)))
+
+	if err := rib.Validate(); err != nil {
+		return "", err
+	}
+	return rib, nil
+}
+
+// Validate checks that the RIB is 20 digits with a known bank code
+//
+// Returns:
+//   - error: nil if valid, otherwise a *ValidationError
+func (r RIB) Validate() error {
+	if r == "" {
+		return newValidationError("rib", ErrEmpty, -1, "20 digits", "RIB cannot be empty")
+	}
+
+	if len(r) != 20 {
+		return lengthError("rib", len(r), 20, "20 digits", "RIB must be exactly 20 digits")
+	}
+
+	if i := scan.FirstNonDigit(string(r)); i >= 0 {
+		return newValidationError("rib", ErrInvalidCharacter, i, "digit", "RIB must contain only digits")
+	}
+
+	// Check if bank code exists
+	if _, exists := constants.Banks[string(r[:2])]; !exists {
+		return newValidationError("rib", ErrUnknownBank, 0, "known bank code", "Bank code not recognized")
+	}
+
+	return nil
+}
+
+// Bank returns the bank of the RIB
+//
+// Returns:
+//   - *BankInfo: bank information or nil if the RIB is invalid
+//
+// Example:
+//
+//	rib, _ := ParseRIB("01234567890123456789")
+//	fmt.Println(rib.Bank().Bank.Name) // Banque Centrale de Tunisie
+func (r RIB) Bank() *BankInfo {
+	if r.Validate() != nil {
+		return nil
+	}
+
+	bankCode := string(r[:2])
+	return &BankInfo{
+		Bank: constants.Banks[bankCode],
+		Code: bankCode,
+	}
+}
+
+// Components returns the components of the RIB
+//
+// Returns:
+//   - RIBComponents: the components, zero if the RIB is invalid
+func (r RIB) Components() RIBComponents {
+	if r.Validate() != nil {
+		return RIBComponents{}
+	}
+
+	return RIBComponents{
+		BankCode:      string(r[:2]),
+		BranchCode:    string(r[2:5]),
+		AccountNumber: string(r[5:18]),
+		Key:           string(r[18:]),
+	}
+}
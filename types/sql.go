@@ -0,0 +1,220 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// The Tunisian identifier types implement sql.Scanner and driver.Valuer.
+// Scanning validates and normalizes the stored value; writing always stores
+// the canonical form returned by the Parse functions (the 8 national digits
+// for phone numbers, whatever their JSON format). NULL columns must be
+// scanned into the Null* wrappers.
+
+// Scan implements sql.Scanner
+func (c *CIN) Scan(src any) error {
+	return scanCanonical(c, "CIN", src)
+}
+
+// Value implements driver.Valuer
+func (c CIN) Value() (driver.Value, error) {
+	return valueCanonical(c, ParseCIN)
+}
+
+// Scan implements sql.Scanner
+func (p *PhoneNumber) Scan(src any) error {
+	return scanCanonical(p, "PhoneNumber", src)
+}
+
+// Value implements driver.Valuer
+func (p PhoneNumber) Value() (driver.Value, error) {
+	return valueCanonical(p, parsePhoneNumber)
+}
+
+// Scan implements sql.Scanner
+func (p *E164PhoneNumber) Scan(src any) error {
+	return scanCanonical(p, "E164PhoneNumber", src)
+}
+
+// Value implements driver.Valuer with the 8 national digits, like PhoneNumber
+func (p E164PhoneNumber) Value() (driver.Value, error) {
+	return PhoneNumber(p).Value()
+}
+
+// Scan implements sql.Scanner
+func (t *TaxID) Scan(src any) error {
+	return scanCanonical(t, "TaxID", src)
+}
+
+// Value implements driver.Valuer
+func (t TaxID) Value() (driver.Value, error) {
+	return valueCanonical(t, ParseTaxID)
+}
+
+// Scan implements sql.Scanner
+func (r *RIB) Scan(src any) error {
+	return scanCanonical(r, "RIB", src)
+}
+
+// Value implements driver.Valuer
+func (r RIB) Value() (driver.Value, error) {
+	return valueCanonical(r, ParseRIB)
+}
+
+// Scan implements sql.Scanner
+func (p *PostalCode) Scan(src any) error {
+	return scanCanonical(p, "PostalCode", src)
+}
+
+// Value implements driver.Valuer
+func (p PostalCode) Value() (driver.Value, error) {
+	return valueCanonical(p, ParsePostalCode)
+}
+
+// NullCIN represents a CIN that may be NULL
+type NullCIN struct {
+	CIN   CIN
+	Valid bool // Valid is true if CIN is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullCIN) Scan(src any) error {
+	n.Valid = src != nil
+	if !n.Valid {
+		n.CIN = ""
+		return nil
+	}
+	return n.CIN.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullCIN) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.CIN.Value()
+}
+
+// NullPhoneNumber represents a PhoneNumber that may be NULL
+type NullPhoneNumber struct {
+	PhoneNumber PhoneNumber
+	Valid       bool // Valid is true if PhoneNumber is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullPhoneNumber) Scan(src any) error {
+	n.Valid = src != nil
+	if !n.Valid {
+		n.PhoneNumber = ""
+		return nil
+	}
+	return n.PhoneNumber.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullPhoneNumber) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.PhoneNumber.Value()
+}
+
+// NullTaxID represents a TaxID that may be NULL
+type NullTaxID struct {
+	TaxID TaxID
+	Valid bool // Valid is true if TaxID is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullTaxID) Scan(src any) error {
+	n.Valid = src != nil
+	if !n.Valid {
+		n.TaxID = ""
+		return nil
+	}
+	return n.TaxID.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullTaxID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.TaxID.Value()
+}
+
+// NullRIB represents a RIB that may be NULL
+type NullRIB struct {
+	RIB   RIB
+	Valid bool // Valid is true if RIB is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullRIB) Scan(src any) error {
+	n.Valid = src != nil
+	if !n.Valid {
+		n.RIB = ""
+		return nil
+	}
+	return n.RIB.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullRIB) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.RIB.Value()
+}
+
+// NullPostalCode represents a PostalCode that may be NULL
+type NullPostalCode struct {
+	PostalCode PostalCode
+	Valid      bool // Valid is true if PostalCode is not NULL
+}
+
+// Scan implements sql.Scanner
+func (n *NullPostalCode) Scan(src any) error {
+	n.Valid = src != nil
+	if !n.Valid {
+		n.PostalCode = ""
+		return nil
+	}
+	return n.PostalCode.Scan(src)
+}
+
+// Value implements driver.Valuer
+func (n NullPostalCode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.PostalCode.Value()
+}
+
+// textCodec is implemented by the pointer of every Tunisian identifier type
+type textCodec interface {
+	UnmarshalText(text []byte) error
+}
+
+// scanCanonical scans a string or []byte column into v
+func scanCanonical(v textCodec, typeName string, src any) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	case nil:
+		return fmt.Errorf("types: cannot scan NULL into %s, use Null%s", typeName, typeName)
+	default:
+		return fmt.Errorf("types: cannot scan %T into %s", src, typeName)
+	}
+}
+
+// valueCanonical returns the canonical string stored for v
+func valueCanonical[T ~string](v T, parse func(string) (T, error)) (driver.Value, error) {
+	text, err := marshalCanonical(v, parse)
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
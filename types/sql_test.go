@@ -0,0 +1,149 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+)
+
+// memDriver is a minimal in-memory database/sql driver.
+// "INSERT ..." statements append their arguments as a row and
+// "SELECT ..." statements return every stored row.
+type memDriver struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+func (d *memDriver) Open(string) (driver.Conn, error) { return &memConn{d: d}, nil }
+
+type memConn struct{ d *memDriver }
+
+func (c *memConn) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{d: c.d, query: query}, nil
+}
+func (c *memConn) Close() error              { return nil }
+func (c *memConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+type memStmt struct {
+	d     *memDriver
+	query string
+}
+
+func (s *memStmt) Close() error  { return nil }
+func (s *memStmt) NumInput() int { return -1 }
+
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *memStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	rows := make([][]driver.Value, len(s.d.rows))
+	copy(rows, s.d.rows)
+	s.d.rows = nil
+
+	var columns []string
+	if len(rows) > 0 {
+		columns = make([]string, len(rows[0]))
+		for i := range columns {
+			columns[i] = fmt.Sprintf("c%d", i)
+		}
+	}
+	return &memRows{columns: columns, rows: rows}, nil
+}
+
+type memRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *memRows) Columns() []string { return r.columns }
+
+func (r *memRows) Close() error { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+var memDB = &memDriver{}
+
+func init() {
+	sql.Register("degache-mem", memDB)
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("degache-mem", "")
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec("INSERT INTO customers VALUES (?, ?, ?, ?, ?, ?)",
+		CIN("12345678"), PhoneNumber("+216 20 123 456"), RIB("01 234 5678901234567 89"),
+		NullTaxID{}, NullPostalCode{PostalCode: "3000", Valid: true}, E164PhoneNumber("+216 98 123 456"))
+	if err != nil {
+		t.Fatalf("INSERT unexpected error: %v", err)
+	}
+
+	memDB.mu.Lock()
+	stored := memDB.rows[0]
+	memDB.mu.Unlock()
+	if stored[1] != "20123456" || stored[2] != "01234567890123456789" || stored[3] != nil || stored[5] != "98123456" {
+		t.Errorf("stored row = %v, want canonical values", stored)
+	}
+
+	var (
+		cin    CIN
+		phone  PhoneNumber
+		rib    RIB
+		taxID  NullTaxID
+		postal NullPostalCode
+		mobile E164PhoneNumber
+	)
+	err = db.QueryRow("SELECT * FROM customers").Scan(&cin, &phone, &rib, &taxID, &postal, &mobile)
+	if err != nil {
+		t.Fatalf("SELECT unexpected error: %v", err)
+	}
+	if cin != "12345678" || phone != "20123456" || rib != "01234567890123456789" || mobile != "98123456" {
+		t.Errorf("scanned %q %q %q %q", string(cin), string(phone), string(rib), string(mobile))
+	}
+	if taxID.Valid || !postal.Valid || postal.PostalCode != "3000" {
+		t.Errorf("scanned nullable values %+v %+v", taxID, postal)
+	}
+}
+
+func TestSQLScanValidates(t *testing.T) {
+	db, err := sql.Open("degache-mem", "")
+	if err != nil {
+		t.Fatalf("sql.Open() unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT INTO customers VALUES (?, ?)", "22345678", nil); err != nil {
+		t.Fatalf("INSERT unexpected error: %v", err)
+	}
+
+	var cin CIN
+	var phone PhoneNumber
+	err = db.QueryRow("SELECT * FROM customers").Scan(&cin, &phone)
+	if !errors.Is(err, ErrBadPrefix) {
+		t.Errorf("Scan() error = %v, want %q", err, ErrBadPrefix)
+	}
+
+	if _, err := db.Exec("INSERT INTO customers VALUES (?)", RIB("99234567890123456789")); !errors.Is(err, ErrUnknownBank) {
+		t.Errorf("INSERT of an invalid RIB error = %v, want %q", err, ErrUnknownBank)
+	}
+}
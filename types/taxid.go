@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/normalize"
+)
+
+// taxIDLayout describes the expected character class at each position of a Tax ID
+// ('9' for a digit, 'A' for an uppercase letter, anything else for a literal)
+const taxIDLayout = scan.TaxIDLayout
+
+// TaxIDComponents contains the components of a Tax ID
+type TaxIDComponents struct {
+	Number   string
+	Type1    string
+	Type2    string
+	Type3    string
+	Sequence string
+}
+
+// ParseTaxID parses a Tax ID after normalizing it (see package normalize) and upper-casing letters
+//
+// Parameters:
+//   - s: The Tax ID to parse
+//
+// Returns:
+//   - TaxID: the validated Tax ID
+//   - error: a *ValidationError if the Tax ID is invalid
+//
+// Example:
+//
+//	taxID, err := ParseTaxID("1234567a/p/m/000")
+//	// Returns: "1234567A/P/M/000", nil
+func ParseTaxID(s string) (TaxID, error) {
+	taxID := TaxID(strings.ToUpper(normalize.String(s)))
+	if err := taxID.Validate(); err != nil {
+		return "", err
+	}
+	return taxID, nil
+}
+
+// Validate checks that the Tax ID follows the format 7 digits + letter/letter/letter/3 digits
+//
+// Returns:
+//   - error: nil if valid, otherwise a *ValidationError
+func (t TaxID) Validate() error {
+	if t == "" {
+		return newValidationError("taxID", ErrEmpty, -1, taxIDLayout, "Tax ID cannot be empty")
+	}
+
+	if len(t) != len(taxIDLayout) {
+		return lengthError("taxID", len(t), len(taxIDLayout), taxIDLayout,
+			fmt.Sprintf("Tax ID must be exactly %d characters long", len(taxIDLayout)))
+	}
+
+	const formatMsg = "Tax ID must follow format: 7 digits + letter/letter/letter/3 digits (e.g., 1234567A/P/M/000)"
+	if i := scan.LayoutMismatch(string(t), taxIDLayout); i >= 0 {
+		switch taxIDLayout[i] {
+		case '9':
+			return newValidationError("taxID", ErrInvalidCharacter, i, "digit", formatMsg)
+		case 'A':
+			return newValidationError("taxID", ErrInvalidCharacter, i, "uppercase letter", formatMsg)
+		default:
+			return newValidationError("taxID", ErrBadFormat, i, fmt.Sprintf("%q", taxIDLayout[i]), formatMsg)
+		}
+	}
+
+	return nil
+}
+
+// Components returns the components of the Tax ID
+//
+// Returns:
+//   - TaxIDComponents: the components, zero if the Tax ID is invalid
+//
+// Example:
+//
+//	taxID, _ := ParseTaxID("1234567A/P/M/000")
+//	fmt.Println(taxID.Components().Number) // 1234567
+func (t TaxID) Components() TaxIDComponents {
+	if t.Validate() != nil {
+		return TaxIDComponents{}
+	}
+
+	return TaxIDComponents{
+		Number:   string(t[:7]),
+		Type1:    string(t[7]),
+		Type2:    string(t[9]),
+		Type3:    string(t[11]),
+		Sequence: string(t[13:]),
+	}
+}
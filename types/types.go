@@ -22,12 +22,20 @@ type TaxID string
 // 20 digits
 type RIB string
 
+// ValidationOptions contains options shared by the CIN, Tax ID, RIB and postal code validators
+type ValidationOptions struct {
+	// Strict disables input normalization (Arabic-Indic digits, invisible
+	// marks, Unicode spaces): the value must already be in plain ASCII
+	Strict bool `json:"strict,omitempty"`
+}
+
 // PhoneNumberValidationOptions contains options for phone number validation
 type PhoneNumberValidationOptions struct {
 	// Strict enforces strict format validation:
 	// - No spaces or special characters allowed
 	// - Must be exactly 8 digits or with +216 prefix
 	// - Must start with a valid carrier prefix
+	// - Input is not normalized (see package normalize)
 	Strict bool
 }
 
@@ -35,7 +43,7 @@ type PhoneNumberValidationOptions struct {
 type CarPlateValidationOptions struct {
 	// Type specifies the type of car plate (standard, special)
 	Type string
-	// Strict enforces strict format validation
+	// Strict enforces strict format validation (exact spacing, no input normalization)
 	Strict bool
 }
 
@@ -74,6 +82,26 @@ type ValidationResult struct {
 	Message string
 }
 
+// FieldReport describes the validation outcome of a single field
+type FieldReport struct {
+	// Valid is true when the value passed validation
+	Valid bool `json:"valid"`
+	// Error is the structured reason when the value is invalid
+	Error *ValidationError `json:"error,omitempty"`
+	// Normalized is the canonical form of a valid value (e.g. a phone number in E.164)
+	Normalized string `json:"normalized,omitempty"`
+	// Metadata contains information extracted from a valid value (carrier, bank, governorate, ...)
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ValidationReport describes the validation outcome of a multi-field record
+type ValidationReport struct {
+	// Valid is true when every field is valid
+	Valid bool `json:"valid"`
+	// Fields contains the report of each field, by field name
+	Fields map[string]FieldReport `json:"fields"`
+}
+
 // CarrierInfo contains information about a mobile carrier
 type CarrierInfo struct {
 	Carrier constants.Carrier
@@ -2,22 +2,17 @@ package validators
 
 import (
 	"fmt"
-	"regexp"
 	"strconv"
 
-	"github.com/degache-go/degache/constants"
 	"github.com/degache-go/degache/types"
 )
 
-// ribRegex is the regular expression for RIB validation
-// 20 digits
-var ribRegex = regexp.MustCompile(`^\d{20}$`)
-
 // ValidateRIB validates a Tunisian RIB (Relevé d'Identité Bancaire)
 // A valid RIB is a 20-digit number
 //
 // Parameters:
 //   - rib: The RIB number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the RIB is valid, false otherwise
@@ -26,25 +21,16 @@ var ribRegex = regexp.MustCompile(`^\d{20}$`)
 //
 //	isValid := ValidateRIB("12345678901234567890") // returns true
 //	isValid := ValidateRIB("1234567890123456789")  // returns false (not 20 digits)
-func ValidateRIB(rib string) bool {
-	if rib == "" {
-		return false
-	}
-
-	if !ribRegex.MatchString(rib) {
-		return false
-	}
-
-	// Additional validation: check if bank code exists
-	bankCode := rib[:2]
-	_, exists := constants.Banks[bankCode]
-	return exists
+func ValidateRIB(rib string, options ...types.ValidationOptions) bool {
+	// The bank code must also exist
+	return isRIB(normalizeInput(rib, options))
 }
 
 // ValidateRIBWithDetails validates a RIB and returns detailed information
 //
 // Parameters:
 //   - rib: The RIB number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the RIB is valid
@@ -56,27 +42,28 @@ func ValidateRIB(rib string) bool {
 //	if !valid {
 //	    fmt.Println("Invalid RIB:", msg)
 //	}
-func ValidateRIBWithDetails(rib string) (bool, string) {
-	if rib == "" {
-		return false, "RIB cannot be empty"
-	}
-
-	if len(rib) != 20 {
-		return false, "RIB must be exactly 20 digits"
-	}
-
-	if !ribRegex.MatchString(rib) {
-		return false, "RIB must contain only digits"
-	}
-
-	// Check if bank code exists
-	bankCode := rib[:2]
-	_, exists := constants.Banks[bankCode]
-	if !exists {
-		return false, "Bank code not recognized"
-	}
+func ValidateRIBWithDetails(rib string, options ...types.ValidationOptions) (bool, string) {
+	return details(CheckRIB(rib, options...))
+}
 
-	return true, ""
+// CheckRIB validates a RIB and returns a structured error describing the failure.
+// Outside strict mode, error positions refer to the normalized input.
+//
+// Parameters:
+//   - rib: The RIB number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckRIB("99345678901234567890")
+//	if errors.Is(err, types.ErrUnknownBank) {
+//	    fmt.Println("Unknown bank")
+//	}
+func CheckRIB(rib string, options ...types.ValidationOptions) error {
+	return types.RIB(normalizeInput(rib, options)).Validate()
 }
 
 // GetBankFromRIB extracts bank information from a RIB
@@ -94,20 +81,12 @@ func ValidateRIBWithDetails(rib string) (bool, string) {
 //	    fmt.Printf("Bank: %s\n", bankInfo.Bank.Name)
 //	}
 func GetBankFromRIB(rib string) *types.BankInfo {
-	if !ValidateRIB(rib) {
+	rib = Normalize(rib)
+	if !ValidateRIB(rib, types.ValidationOptions{Strict: true}) {
 		return nil
 	}
 
-	bankCode := rib[:2]
-	bank, exists := constants.Banks[bankCode]
-	if !exists {
-		return nil
-	}
-
-	return &types.BankInfo{
-		Bank: bank,
-		Code: bankCode,
-	}
+	return types.RIB(rib).Bank()
 }
 
 // ExtractRIBComponents extracts components from a valid RIB
@@ -127,18 +106,24 @@ func GetBankFromRIB(rib string) *types.BankInfo {
 //	        components["bankCode"], components["branchCode"], components["accountNumber"], components["key"])
 //	}
 func ExtractRIBComponents(rib string) (map[string]string, error) {
-	valid, msg := ValidateRIBWithDetails(rib)
-	if !valid {
-		return nil, fmt.Errorf("invalid RIB: %s", msg)
+	rib = Normalize(rib)
+	if err := CheckRIB(rib, types.ValidationOptions{Strict: true}); err != nil {
+		return nil, fmt.Errorf("invalid RIB: %w", err)
 	}
 
+	return ribComponents(types.RIB(rib)), nil
+}
+
+// ribComponents returns the components of a valid RIB keyed as in ExtractRIBComponents
+func ribComponents(rib types.RIB) map[string]string {
+	parts := rib.Components()
 	components := make(map[string]string)
-	components["bankCode"] = rib[:2]
-	components["branchCode"] = rib[2:5]
-	components["accountNumber"] = rib[5:18]
-	components["key"] = rib[18:]
+	components["bankCode"] = parts.BankCode
+	components["branchCode"] = parts.BranchCode
+	components["accountNumber"] = parts.AccountNumber
+	components["key"] = parts.Key
 
-	return components, nil
+	return components
 }
 
 // ValidateRIBChecksum validates the RIB checksum (simplified version)
@@ -146,11 +131,13 @@ func ExtractRIBComponents(rib string) (map[string]string, error) {
 //
 // Parameters:
 //   - rib: The RIB to validate checksum for
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if checksum is valid, false otherwise
-func ValidateRIBChecksum(rib string) bool {
-	if !ValidateRIB(rib) {
+func ValidateRIBChecksum(rib string, options ...types.ValidationOptions) bool {
+	rib = normalizeInput(rib, options)
+	if !ValidateRIB(rib, types.ValidationOptions{Strict: true}) {
 		return false
 	}
 
@@ -162,12 +149,42 @@ func ValidateRIBChecksum(rib string) bool {
 
 	// Simple modulo 97 check (this is a simplified version)
 	// Real implementation would require the full IBAN algorithm
-	accountPart := rib[:18]
-	accountNum, err := strconv.ParseInt(accountPart, 10, 64)
-	if err != nil {
-		return false
+	return ribKey(rib) == key
+}
+
+// CheckRIBChecksum validates a RIB including its control key and returns a structured error
+// See ValidateRIBChecksum for the (simplified) key algorithm
+//
+// Parameters:
+//   - rib: The RIB to validate
+//   - options: Validation options (optional, Strict disables input normalization)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	if errors.Is(CheckRIBChecksum(rib), types.ErrChecksum) {
+//	    fmt.Println("RIB key does not match")
+//	}
+func CheckRIBChecksum(rib string, options ...types.ValidationOptions) error {
+	rib = normalizeInput(rib, options)
+	if err := CheckRIB(rib, types.ValidationOptions{Strict: true}); err != nil {
+		return err
 	}
 
-	calculatedKey := int(accountNum % 97)
-	return calculatedKey == key
+	if !ValidateRIBChecksum(rib, types.ValidationOptions{Strict: true}) {
+		return newValidationError("rib", types.ErrChecksum, 18, fmt.Sprintf("%02d", ribKey(rib)), "RIB key is invalid")
+	}
+
+	return nil
+}
+
+// ribKey computes the expected control key for a RIB whose first 18 digits are valid
+func ribKey(rib string) int {
+	accountNum, err := strconv.ParseInt(rib[:18], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return int(accountNum % 97)
 }
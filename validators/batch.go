@@ -0,0 +1,230 @@
+package validators
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/degache-go/degache/types"
+)
+
+// BatchOptions configures ValidateBatch
+type BatchOptions struct {
+	// Workers is the number of goroutines validating records (default runtime.GOMAXPROCS(0))
+	Workers int `json:"workers,omitempty"`
+	// Ordered delivers results in input order; otherwise they are delivered as soon as they complete
+	Ordered bool `json:"ordered,omitempty"`
+	// Fields contains the options of each field, keyed by field name
+	Fields map[string]Options `json:"fields,omitempty"`
+}
+
+// BatchResult is the validation outcome of one record of a batch
+type BatchResult struct {
+	// Index is the position of the record in the input
+	Index int `json:"index"`
+	// Report describes the validation outcome of every field of the record
+	Report types.ValidationReport `json:"report"`
+}
+
+// BatchSummary aggregates the outcomes of a batch
+type BatchSummary struct {
+	// Total is the number of records in the batch
+	Total int `json:"total"`
+	// Processed is the number of results delivered
+	Processed int `json:"processed"`
+	// Valid is the number of valid records
+	Valid int `json:"valid"`
+	// Invalid is the number of invalid records
+	Invalid int `json:"invalid"`
+	// FieldErrors counts the invalid values of each field
+	FieldErrors map[string]int `json:"fieldErrors"`
+	// Err is the context error if the batch was cancelled before every record was processed
+	Err error `json:"-"`
+}
+
+// add accounts for a delivered result
+func (s *BatchSummary) add(result BatchResult) {
+	s.Processed++
+	if result.Report.Valid {
+		s.Valid++
+	} else {
+		s.Invalid++
+	}
+
+	for field, fieldReport := range result.Report.Fields {
+		if !fieldReport.Valid {
+			s.FieldErrors[field]++
+		}
+	}
+}
+
+// Batch is a batch validation running in the background
+type Batch struct {
+	results chan BatchResult
+	done    chan struct{}
+	summary BatchSummary
+}
+
+// Results returns the channel delivering the result of each record.
+// It is closed once every record has been processed or the context is done.
+func (b *Batch) Results() <-chan BatchResult {
+	return b.results
+}
+
+// Wait discards the results that were not read, waits for the batch to finish
+// and returns its summary. It must not be called while another goroutine reads Results.
+func (b *Batch) Wait() BatchSummary {
+	for range b.results {
+	}
+	<-b.done
+	return b.summary
+}
+
+// batchJob is a record waiting to be validated
+type batchJob struct {
+	index int
+	out   chan<- BatchResult
+}
+
+// ValidateBatch validates records concurrently using a bounded pool of workers
+//
+// Every record maps field names to values and is validated like Report does:
+// fields without a registered validator are reported as invalid.
+// Validation stops as soon as ctx is cancelled or its deadline expires.
+//
+// Parameters:
+//   - ctx: The context controlling the batch
+//   - records: The records to validate
+//   - opts: Batch options
+//
+// Returns:
+//   - *Batch: the running batch; read Results, then call Wait for the summary
+//
+// Example:
+//
+//	batch := r.ValidateBatch(ctx, records, BatchOptions{Workers: 8, Ordered: true})
+//	for result := range batch.Results() {
+//	    if !result.Report.Valid {
+//	        fmt.Println("invalid record", result.Index)
+//	    }
+//	}
+//	summary := batch.Wait()
+func (r *Registry) ValidateBatch(ctx context.Context, records []map[string]string, opts BatchOptions) *Batch {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(records) {
+		workers = len(records)
+	}
+
+	b := &Batch{
+		results: make(chan BatchResult),
+		done:    make(chan struct{}),
+		summary: BatchSummary{
+			Total:       len(records),
+			FieldErrors: make(map[string]int),
+		},
+	}
+
+	go b.run(ctx, r, records, workers, opts)
+	return b
+}
+
+// run feeds the workers, collects their results and delivers them
+func (b *Batch) run(ctx context.Context, r *Registry, records []map[string]string, workers int, opts BatchOptions) {
+	defer close(b.done)
+	defer close(b.results)
+
+	jobs := make(chan batchJob)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.out <- BatchResult{Index: job.index, Report: r.Report(records[job.index], opts.Fields)}
+			}
+		}()
+	}
+
+	if opts.Ordered {
+		// Each record gets its own result channel; the channels are queued
+		// in input order, which bounds the results waiting to be delivered
+		queue := make(chan chan BatchResult, workers)
+		go func() {
+			defer close(queue)
+			defer close(jobs)
+			for i := range records {
+				out := make(chan BatchResult, 1)
+				if !send(ctx, jobs, batchJob{index: i, out: out}) || !send(ctx, queue, out) {
+					return
+				}
+			}
+		}()
+
+		for out := range queue {
+			b.deliver(ctx, <-out)
+		}
+	} else {
+		completed := make(chan BatchResult, workers)
+		go func() {
+			defer close(jobs)
+			for i := range records {
+				if !send(ctx, jobs, batchJob{index: i, out: completed}) {
+					return
+				}
+			}
+		}()
+		go func() {
+			wg.Wait()
+			close(completed)
+		}()
+
+		for result := range completed {
+			b.deliver(ctx, result)
+		}
+	}
+
+	wg.Wait()
+	if b.summary.Processed < b.summary.Total {
+		b.summary.Err = ctx.Err()
+	}
+}
+
+// deliver sends a result to the reader unless ctx is done, in which case the result is discarded
+func (b *Batch) deliver(ctx context.Context, result BatchResult) {
+	if send(ctx, b.results, result) {
+		b.summary.add(result)
+	}
+}
+
+// send sends v on ch unless ctx is done
+func send[T any](ctx context.Context, ch chan<- T, v T) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// ValidateBatch validates records concurrently using DefaultRegistry
+//
+// Example:
+//
+//	batch := ValidateBatch(ctx, []map[string]string{
+//	    {"cin": "12345678", "phone": "20123456"},
+//	    {"cin": "22345678"},
+//	}, BatchOptions{})
+//	for result := range batch.Results() {
+//	    fmt.Println(result.Index, result.Report.Valid)
+//	}
+//	fmt.Println(batch.Wait().Invalid) // 1
+func ValidateBatch(ctx context.Context, records []map[string]string, opts BatchOptions) *Batch {
+	return DefaultRegistry.ValidateBatch(ctx, records, opts)
+}
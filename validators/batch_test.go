@@ -0,0 +1,139 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// batchRecords returns n records, every third one having an invalid CIN
+func batchRecords(n int) []map[string]string {
+	records := make([]map[string]string, n)
+	for i := range records {
+		cin := "12345678"
+		if i%3 == 0 {
+			cin = "22345678"
+		}
+		records[i] = map[string]string{"cin": cin, "phone": "20123456"}
+	}
+	return records
+}
+
+func TestValidateBatch(t *testing.T) {
+	for _, ordered := range []bool{true, false} {
+		t.Run(fmt.Sprintf("ordered=%v", ordered), func(t *testing.T) {
+			records := batchRecords(100)
+			batch := ValidateBatch(context.Background(), records, BatchOptions{Workers: 4, Ordered: ordered})
+
+			seen := make(map[int]bool)
+			next := 0
+			for result := range batch.Results() {
+				if ordered && result.Index != next {
+					t.Fatalf("result index = %d, want %d", result.Index, next)
+				}
+				next++
+				seen[result.Index] = true
+
+				if want := result.Index%3 != 0; result.Report.Valid != want {
+					t.Errorf("record %d valid = %v, want %v", result.Index, result.Report.Valid, want)
+				}
+			}
+			if len(seen) != len(records) {
+				t.Errorf("received %d distinct results, want %d", len(seen), len(records))
+			}
+
+			summary := batch.Wait()
+			if summary.Total != 100 || summary.Processed != 100 || summary.Valid != 66 || summary.Invalid != 34 {
+				t.Errorf("Wait() = %+v, want 100 processed, 66 valid, 34 invalid", summary)
+			}
+			if summary.FieldErrors["cin"] != 34 || summary.FieldErrors["phone"] != 0 {
+				t.Errorf("FieldErrors = %v, want cin:34", summary.FieldErrors)
+			}
+			if summary.Err != nil {
+				t.Errorf("Err = %v, want nil", summary.Err)
+			}
+		})
+	}
+}
+
+func TestValidateBatchOptions(t *testing.T) {
+	records := []map[string]string{{"phone": "20 123 456"}, {"badge": "B-1"}}
+	batch := ValidateBatch(context.Background(), records, BatchOptions{
+		Ordered: true,
+		Fields:  map[string]Options{"phone": {Strict: true}},
+	})
+
+	summary := batch.Wait()
+	if summary.Invalid != 2 || summary.FieldErrors["phone"] != 1 || summary.FieldErrors["badge"] != 1 {
+		t.Errorf("Wait() = %+v, want strict phone and unknown field to fail", summary)
+	}
+}
+
+func TestValidateBatchEmpty(t *testing.T) {
+	summary := ValidateBatch(context.Background(), nil, BatchOptions{}).Wait()
+	if summary.Total != 0 || summary.Processed != 0 || summary.Err != nil {
+		t.Errorf("Wait() = %+v, want an empty summary", summary)
+	}
+}
+
+func TestValidateBatchCancellation(t *testing.T) {
+	t.Run("cancelled before start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		summary := ValidateBatch(ctx, batchRecords(10), BatchOptions{}).Wait()
+		if summary.Processed != 0 || !errors.Is(summary.Err, context.Canceled) {
+			t.Errorf("Wait() = %+v, want nothing processed and context.Canceled", summary)
+		}
+	})
+
+	for _, ordered := range []bool{true, false} {
+		t.Run(fmt.Sprintf("cancelled while running, ordered=%v", ordered), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			batch := ValidateBatch(ctx, batchRecords(1000), BatchOptions{Workers: 2, Ordered: ordered})
+			received := 0
+			for range batch.Results() {
+				received++
+				if received == 10 {
+					cancel()
+				}
+			}
+
+			summary := batch.Wait()
+			if summary.Processed != received || summary.Processed >= summary.Total {
+				t.Errorf("Processed = %d, received %d of %d", summary.Processed, received, summary.Total)
+			}
+			if !errors.Is(summary.Err, context.Canceled) {
+				t.Errorf("Err = %v, want context.Canceled", summary.Err)
+			}
+		})
+	}
+
+	t.Run("deadline", func(t *testing.T) {
+		r := NewRegistry()
+		slow := NewValidator("slow", func(string, Options) error {
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		})
+		if err := r.Register(slow); err != nil {
+			t.Fatal(err)
+		}
+
+		records := make([]map[string]string, 200)
+		for i := range records {
+			records[i] = map[string]string{"slow": "x"}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		summary := r.ValidateBatch(ctx, records, BatchOptions{Workers: 2}).Wait()
+		if !errors.Is(summary.Err, context.DeadlineExceeded) || summary.Processed >= len(records) {
+			t.Errorf("Wait() = %+v, want the deadline to stop the batch", summary)
+		}
+	})
+}
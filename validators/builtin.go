@@ -0,0 +1,187 @@
+package validators
+
+import (
+	"github.com/degache-go/degache/types"
+)
+
+// builtinValidator is a Validator that also implements Normalizer, Describer and Analyzer
+type builtinValidator struct {
+	name  string
+	check func(value string, opts Options) error
+	// analyze parses the value once and derives its normalized form and metadata
+	analyze func(value string, opts Options) (string, map[string]string, error)
+}
+
+func (v builtinValidator) Name() string {
+	return v.name
+}
+
+func (v builtinValidator) Check(value string, opts Options) error {
+	return v.check(value, opts)
+}
+
+func (v builtinValidator) Normalize(value string, opts Options) (string, error) {
+	normalized, _, err := v.analyze(value, opts)
+	if err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+func (v builtinValidator) Describe(value string, opts Options) map[string]string {
+	_, metadata, err := v.analyze(value, opts)
+	if err != nil {
+		return nil
+	}
+	return metadata
+}
+
+func (v builtinValidator) Analyze(value string, opts Options) (string, map[string]string, error) {
+	return v.analyze(value, opts)
+}
+
+// validationOptions converts registry options to the options of the CIN, Tax ID, RIB and postal code validators
+func validationOptions(opts Options) types.ValidationOptions {
+	return types.ValidationOptions{Strict: opts.Strict}
+}
+
+// normalizeValue returns the normalized form of a value that passed validation
+func normalizeValue(value string, opts Options) string {
+	if opts.Strict {
+		return value
+	}
+	return Normalize(value)
+}
+
+// phoneOptions converts registry options to phone validation options
+func phoneOptions(opts Options) types.PhoneNumberValidationOptions {
+	return types.PhoneNumberValidationOptions{Strict: opts.Strict}
+}
+
+// carPlateOptions converts registry options to car plate validation options
+func carPlateOptions(opts Options) types.CarPlateValidationOptions {
+	return types.CarPlateValidationOptions{Type: opts.Params["type"], Strict: opts.Strict}
+}
+
+// builtinValidators returns the validators registered in DefaultRegistry
+func builtinValidators() []Validator {
+	return []Validator{
+		builtinValidator{
+			name: "cin",
+			check: func(value string, opts Options) error {
+				return CheckCIN(value, validationOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				cin := types.CIN(normalizeValue(value, opts))
+				if err := cin.Validate(); err != nil {
+					return "", nil, err
+				}
+				return string(cin), nil, nil
+			},
+		},
+		builtinValidator{
+			name: "phone",
+			check: func(value string, opts Options) error {
+				return CheckPhoneNumber(value, phoneOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				phone, err := types.ParsePhoneNumber(value, phoneOptions(opts))
+				if err != nil {
+					return "", nil, err
+				}
+				var metadata map[string]string
+				if info := phone.Carrier(); info != nil {
+					metadata = map[string]string{
+						"carrier": info.Carrier.Name,
+						"prefix":  info.Prefix,
+					}
+				}
+				return phone.E164(), metadata, nil
+			},
+		},
+		builtinValidator{
+			name: "taxID",
+			check: func(value string, opts Options) error {
+				return CheckTaxID(value, validationOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				taxID := types.TaxID(normalizeValue(value, opts))
+				if err := taxID.Validate(); err != nil {
+					return "", nil, err
+				}
+				return string(taxID), taxIDComponents(taxID), nil
+			},
+		},
+		builtinValidator{
+			name: "rib",
+			check: func(value string, opts Options) error {
+				return CheckRIB(value, validationOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				rib := types.RIB(normalizeValue(value, opts))
+				if err := rib.Validate(); err != nil {
+					return "", nil, err
+				}
+				metadata := ribComponents(rib)
+				if info := rib.Bank(); info != nil {
+					metadata["bank"] = info.Bank.Name
+				}
+				return string(rib), metadata, nil
+			},
+		},
+		builtinValidator{
+			name: "postal",
+			check: func(value string, opts Options) error {
+				return CheckPostalCode(value, validationOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				postalCode := types.PostalCode(normalizeValue(value, opts))
+				if err := postalCode.Validate(); err != nil {
+					return "", nil, err
+				}
+				var metadata map[string]string
+				if governorate := postalCode.Governorate(); governorate != nil {
+					metadata = map[string]string{
+						"governorate": governorate.Name,
+						"region":      governorate.Region,
+					}
+				}
+				return string(postalCode), metadata, nil
+			},
+		},
+		builtinValidator{
+			name: "carPlate",
+			check: func(value string, opts Options) error {
+				return CheckCarPlate(value, carPlateOptions(opts))
+			},
+			analyze: func(value string, opts Options) (string, map[string]string, error) {
+				plate := value
+				if !opts.Strict {
+					plate = normalizeCarPlate(value)
+				}
+				info := carPlateInfo(plate, carPlateOptions(opts))
+				if info == nil {
+					// Only invalid plates are checked again, for the reason of the failure
+					return "", nil, CheckCarPlate(value, carPlateOptions(opts))
+				}
+				return plate, map[string]string{
+					"type":   info.Type,
+					"prefix": info.Components.Prefix,
+					"region": info.Components.Region,
+					"suffix": info.Components.Suffix,
+				}, nil
+			},
+		},
+	}
+}
+
+// newDefaultRegistry creates a registry with the built-in validators
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	for _, v := range builtinValidators() {
+		if err := r.Register(v); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
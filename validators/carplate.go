@@ -1,23 +1,11 @@
 package validators
 
 import (
-	"regexp"
 	"strings"
 
 	"github.com/degache-go/degache/types"
 )
 
-// Regular expressions for car plate validation
-var (
-	// Standard format: XXX تونس XXXX
-	standardCarPlateRegex = regexp.MustCompile(`^\d{3}\s*تونس\s*\d{4}$`)
-	// Special format: RS XXX تونس
-	specialCarPlateRegex = regexp.MustCompile(`^RS\s*\d{3}\s*تونس$`)
-	// Strict format (no extra spaces)
-	strictStandardCarPlateRegex = regexp.MustCompile(`^\d{3} تونس \d{4}$`)
-	strictSpecialCarPlateRegex  = regexp.MustCompile(`^RS \d{3} تونس$`)
-)
-
 // ValidateCarPlate validates a Tunisian car plate
 // Supports both standard (XXX تونس XXXX) and special (RS XXX تونس) formats
 //
@@ -43,25 +31,20 @@ func ValidateCarPlate(carPlate string, options ...types.CarPlateValidationOption
 		opts = options[0]
 	}
 
-	// Normalize spaces if not in strict mode
-	normalizedPlate := carPlate
+	// Normalize input if not in strict mode
+	plate := carPlate
 	if !opts.Strict {
-		// Replace multiple spaces with single space
-		normalizedPlate = regexp.MustCompile(`\s+`).ReplaceAllString(strings.TrimSpace(carPlate), " ")
+		plate = Normalize(carPlate)
 	}
 
 	// Check based on type
 	switch opts.Type {
 	case "special":
-		if opts.Strict {
-			return strictSpecialCarPlateRegex.MatchString(normalizedPlate)
-		}
-		return specialCarPlateRegex.MatchString(normalizedPlate)
+		_, ok := matchSpecialPlate(plate, opts.Strict)
+		return ok
 	case "standard", "":
-		if opts.Strict {
-			return strictStandardCarPlateRegex.MatchString(normalizedPlate)
-		}
-		return standardCarPlateRegex.MatchString(normalizedPlate)
+		_, _, ok := matchStandardPlate(plate, opts.Strict)
+		return ok
 	default:
 		return false
 	}
@@ -84,8 +67,27 @@ func ValidateCarPlate(carPlate string, options ...types.CarPlateValidationOption
 //	    fmt.Println("Invalid car plate:", msg)
 //	}
 func ValidateCarPlateWithDetails(carPlate string, options ...types.CarPlateValidationOptions) (bool, string) {
+	return details(CheckCarPlate(carPlate, options...))
+}
+
+// CheckCarPlate validates a car plate and returns a structured error describing the failure
+//
+// Parameters:
+//   - carPlate: The car plate to validate
+//   - options: Validation options (optional)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckCarPlate("123 4567")
+//	if verr := AsValidationError(err); verr != nil {
+//	    fmt.Println(verr.Code, verr.Expected) // bad_format تونس
+//	}
+func CheckCarPlate(carPlate string, options ...types.CarPlateValidationOptions) error {
 	if carPlate == "" {
-		return false, "Car plate cannot be empty"
+		return newValidationError("carPlate", types.ErrEmpty, -1, "XXX تونس XXXX", "Car plate cannot be empty")
 	}
 
 	var opts types.CarPlateValidationOptions
@@ -93,44 +95,43 @@ func ValidateCarPlateWithDetails(carPlate string, options ...types.CarPlateValid
 		opts = options[0]
 	}
 
-	// Check if it contains the required Arabic text
-	if !strings.Contains(carPlate, "تونس") {
-		return false, "Car plate must contain 'تونس'"
+	// Normalize input if not in strict mode
+	plate := carPlate
+	if !opts.Strict {
+		plate = Normalize(carPlate)
 	}
 
-	// Normalize spaces if not in strict mode
-	normalizedPlate := carPlate
-	if !opts.Strict {
-		normalizedPlate = regexp.MustCompile(`\s+`).ReplaceAllString(strings.TrimSpace(carPlate), " ")
+	// Check if it contains the required Arabic text
+	if !strings.Contains(plate, carPlateRegion) {
+		return newValidationError("carPlate", types.ErrBadFormat, -1, "تونس", "Car plate must contain 'تونس'")
 	}
 
 	// Check based on type
 	switch opts.Type {
 	case "special":
+		if _, ok := matchSpecialPlate(plate, opts.Strict); ok {
+			return nil
+		}
 		if opts.Strict {
-			if !strictSpecialCarPlateRegex.MatchString(normalizedPlate) {
-				return false, "Special car plate must follow format: RS XXX تونس (with exact spacing)"
-			}
-		} else {
-			if !specialCarPlateRegex.MatchString(normalizedPlate) {
-				return false, "Special car plate must follow format: RS XXX تونس"
-			}
+			return newValidationError("carPlate", types.ErrBadFormat, -1, "RS XXX تونس",
+				"Special car plate must follow format: RS XXX تونس (with exact spacing)")
 		}
+		return newValidationError("carPlate", types.ErrBadFormat, -1, "RS XXX تونس",
+			"Special car plate must follow format: RS XXX تونس")
 	case "standard", "":
+		if _, _, ok := matchStandardPlate(plate, opts.Strict); ok {
+			return nil
+		}
 		if opts.Strict {
-			if !strictStandardCarPlateRegex.MatchString(normalizedPlate) {
-				return false, "Standard car plate must follow format: XXX تونس XXXX (with exact spacing)"
-			}
-		} else {
-			if !standardCarPlateRegex.MatchString(normalizedPlate) {
-				return false, "Standard car plate must follow format: XXX تونس XXXX"
-			}
+			return newValidationError("carPlate", types.ErrBadFormat, -1, "XXX تونس XXXX",
+				"Standard car plate must follow format: XXX تونس XXXX (with exact spacing)")
 		}
+		return newValidationError("carPlate", types.ErrBadFormat, -1, "XXX تونس XXXX",
+			"Standard car plate must follow format: XXX تونس XXXX")
 	default:
-		return false, "Invalid car plate type specified"
+		return newValidationError("carPlate", types.ErrUnsupportedType, -1, "standard or special",
+			"Invalid car plate type specified")
 	}
-
-	return true, ""
 }
 
 // GetCarPlateInfo extracts information from a valid car plate
@@ -155,40 +156,38 @@ func GetCarPlateInfo(carPlate string, options ...types.CarPlateValidationOptions
 		opts = options[0]
 	}
 
-	if !ValidateCarPlate(carPlate, opts) {
-		return nil
-	}
-
-	// Normalize spaces
-	normalizedPlate := carPlate
+	// Normalize input if not in strict mode
+	plate := carPlate
 	if !opts.Strict {
-		normalizedPlate = regexp.MustCompile(`\s+`).ReplaceAllString(strings.TrimSpace(carPlate), " ")
+		plate = Normalize(carPlate)
 	}
 
-	// Determine type and extract components
-	if strings.HasPrefix(normalizedPlate, "RS") {
-		// Special plate
-		parts := strings.Fields(normalizedPlate)
-		if len(parts) >= 3 {
+	return carPlateInfo(plate, opts)
+}
+
+// carPlateInfo extracts information from a car plate that is already normalized
+func carPlateInfo(plate string, opts types.CarPlateValidationOptions) *types.CarPlateInfo {
+	// Extract components based on type
+	switch opts.Type {
+	case "special":
+		if number, ok := matchSpecialPlate(plate, opts.Strict); ok {
 			return &types.CarPlateInfo{
 				Type: "special",
 				Components: types.CarPlateComponents{
-					Prefix: parts[0] + " " + parts[1], // "RS XXX"
-					Region: parts[2],                  // "تونس"
-					Suffix: "",                        // No suffix for special plates
+					Prefix: "RS " + number, // "RS XXX"
+					Region: carPlateRegion, // "تونس"
+					Suffix: "",             // No suffix for special plates
 				},
 			}
 		}
-	} else {
-		// Standard plate
-		parts := strings.Fields(normalizedPlate)
-		if len(parts) >= 3 {
+	case "standard", "":
+		if prefix, suffix, ok := matchStandardPlate(plate, opts.Strict); ok {
 			return &types.CarPlateInfo{
 				Type: "standard",
 				Components: types.CarPlateComponents{
-					Prefix: parts[0], // "XXX"
-					Region: parts[1], // "تونس"
-					Suffix: parts[2], // "XXXX"
+					Prefix: prefix,         // "XXX"
+					Region: carPlateRegion, // "تونس"
+					Suffix: suffix,         // "XXXX"
 				},
 			}
 		}
@@ -1,18 +1,15 @@
 package validators
 
 import (
-	"regexp"
+	"github.com/degache-go/degache/types"
 )
 
-// cinRegex is the regular expression for CIN validation
-// 0 or 1 followed by 7 digits to match the Tunisian CIN format
-var cinRegex = regexp.MustCompile(`^[01]\d{7}$`)
-
 // ValidateCIN validates a Tunisian CIN (Carte d'Identité Nationale)
 // A valid CIN is an 8-digit number starting with 0 or 1
 //
 // Parameters:
 //   - cin: The CIN number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the CIN is valid, false otherwise
@@ -22,18 +19,16 @@ var cinRegex = regexp.MustCompile(`^[01]\d{7}$`)
 //	isValid := ValidateCIN("12345678") // returns true
 //	isValid := ValidateCIN("22345678") // returns false (doesn't start with 0 or 1)
 //	isValid := ValidateCIN("1234567")  // returns false (not 8 digits)
-func ValidateCIN(cin string) bool {
-	if cin == "" {
-		return false
-	}
-
-	return cinRegex.MatchString(cin)
+//	isValid := ValidateCIN("١٢٣٤٥٦٧٨") // returns true (Arabic-Indic digits)
+func ValidateCIN(cin string, options ...types.ValidationOptions) bool {
+	return isCIN(normalizeInput(cin, options))
 }
 
 // ValidateCINWithDetails validates a CIN and returns detailed information
 //
 // Parameters:
 //   - cin: The CIN number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the CIN is valid
@@ -45,18 +40,26 @@ func ValidateCIN(cin string) bool {
 //	if !valid {
 //	    fmt.Println("Invalid CIN:", msg)
 //	}
-func ValidateCINWithDetails(cin string) (bool, string) {
-	if cin == "" {
-		return false, "CIN cannot be empty"
-	}
-
-	if len(cin) != 8 {
-		return false, "CIN must be exactly 8 digits"
-	}
-
-	if !cinRegex.MatchString(cin) {
-		return false, "CIN must start with 0 or 1 and contain only digits"
-	}
+func ValidateCINWithDetails(cin string, options ...types.ValidationOptions) (bool, string) {
+	return details(CheckCIN(cin, options...))
+}
 
-	return true, ""
+// CheckCIN validates a CIN and returns a structured error describing the failure.
+// Outside strict mode, error positions refer to the normalized input.
+//
+// Parameters:
+//   - cin: The CIN number to validate
+//   - options: Validation options (optional, Strict disables input normalization)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckCIN("2234567a")
+//	if errors.Is(err, types.ErrBadPrefix) {
+//	    fmt.Println("CIN must start with 0 or 1")
+//	}
+func CheckCIN(cin string, options ...types.ValidationOptions) error {
+	return types.CIN(normalizeInput(cin, options)).Validate()
 }
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"errors"
+
+	"github.com/degache-go/degache/types"
+)
+
+// newValidationError builds a *types.ValidationError for the given field
+func newValidationError(field string, code types.ErrorCode, position int, expected, message string) *types.ValidationError {
+	return &types.ValidationError{
+		Code:     code,
+		Field:    field,
+		Position: position,
+		Expected: expected,
+		Message:  message,
+	}
+}
+
+// details converts the result of a Check function to the (bool, string) pair
+// returned by the *WithDetails functions
+func details(err error) (bool, string) {
+	if err == nil {
+		return true, ""
+	}
+	return false, err.Error()
+}
+
+// AsValidationError extracts a *types.ValidationError from err
+//
+// Parameters:
+//   - err: The error returned by a Check function
+//
+// Returns:
+//   - *types.ValidationError: the validation error, or nil if err is not one
+//
+// Example:
+//
+//	if verr := AsValidationError(CheckCIN("2234567")); verr != nil {
+//	    fmt.Println(verr.Code, verr.Position)
+//	}
+func AsValidationError(err error) *types.ValidationError {
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		return verr
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+)
+
+func TestCheckFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		check    func() error
+		code     types.ErrorCode
+		field    string
+		position int
+	}{
+		{"Valid CIN", func() error { return CheckCIN("12345678") }, "", "", 0},
+		{"Empty CIN", func() error { return CheckCIN("") }, types.ErrEmpty, "cin", -1},
+		{"Short CIN", func() error { return CheckCIN("1234567") }, types.ErrTooShort, "cin", -1},
+		{"Long CIN", func() error { return CheckCIN("123456789") }, types.ErrTooLong, "cin", -1},
+		{"CIN bad prefix", func() error { return CheckCIN("22345678") }, types.ErrBadPrefix, "cin", 0},
+		{"CIN letter", func() error { return CheckCIN("1234567a") }, types.ErrInvalidCharacter, "cin", 7},
+
+		{"Valid phone", func() error { return CheckPhoneNumber("+216 20 123 456") }, "", "", 0},
+		{"Phone bad prefix", func() error { return CheckPhoneNumber("+216 10 123 456") }, types.ErrBadPrefix, "phone", 5},
+		{"Phone too short", func() error { return CheckPhoneNumber("2012345") }, types.ErrTooShort, "phone", -1},
+		{"Phone strict format", func() error {
+			return CheckPhoneNumber("20 123 456", types.PhoneNumberValidationOptions{Strict: true})
+		}, types.ErrBadFormat, "phone", -1},
+
+		{"Valid tax ID", func() error { return CheckTaxID("1234567A/P/M/000") }, "", "", 0},
+		{"Tax ID lowercase", func() error { return CheckTaxID("1234567a/P/M/000") }, types.ErrInvalidCharacter, "taxID", 7},
+		{"Tax ID separator", func() error { return CheckTaxID("1234567A-P/M/000") }, types.ErrBadFormat, "taxID", 8},
+		{"Tax ID short", func() error { return CheckTaxID("123456A/P/M/000") }, types.ErrTooShort, "taxID", -1},
+
+		{"Valid RIB", func() error { return CheckRIB("01234567890123456789") }, "", "", 0},
+		{"RIB letter", func() error { return CheckRIB("0123456789012345678x") }, types.ErrInvalidCharacter, "rib", 19},
+		{"RIB unknown bank", func() error { return CheckRIB("99234567890123456789") }, types.ErrUnknownBank, "rib", 0},
+		{"RIB checksum", func() error { return CheckRIBChecksum("01234567890123456789") }, types.ErrChecksum, "rib", 18},
+
+		{"Valid postal code", func() error { return CheckPostalCode("1000") }, "", "", 0},
+		{"Postal code letter", func() error { return CheckPostalCode("10a0") }, types.ErrInvalidCharacter, "postal", 2},
+
+		{"Valid car plate", func() error { return CheckCarPlate("123 تونس 4567") }, "", "", 0},
+		{"Car plate without region", func() error { return CheckCarPlate("123 4567") }, types.ErrBadFormat, "carPlate", -1},
+		{"Car plate unknown type", func() error {
+			return CheckCarPlate("123 تونس 4567", types.CarPlateValidationOptions{Type: "diplomatic"})
+		}, types.ErrUnsupportedType, "carPlate", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check()
+			if tt.code == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.code) {
+				t.Fatalf("error %v does not match code %q", err, tt.code)
+			}
+
+			var verr *types.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("error %T is not a *types.ValidationError", err)
+			}
+			if verr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", verr.Field, tt.field)
+			}
+			if verr.Position != tt.position {
+				t.Errorf("Position = %d, want %d", verr.Position, tt.position)
+			}
+			if verr.Message == "" {
+				t.Error("Message should not be empty")
+			}
+		})
+	}
+}
+
+func TestValidateTaxIDWithDetails(t *testing.T) {
+	ok, msg := ValidateTaxIDWithDetails("1234567A/P/M/000")
+	if !ok || msg != "" {
+		t.Errorf("ValidateTaxIDWithDetails(valid) = %v, %q; want true, \"\"", ok, msg)
+	}
+
+	ok, msg = ValidateTaxIDWithDetails("1234567A/P/M/00")
+	if ok || msg != "Tax ID must be exactly 16 characters long" {
+		t.Errorf("ValidateTaxIDWithDetails(short) = %v, %q", ok, msg)
+	}
+}
@@ -0,0 +1,32 @@
+package validators
+
+import (
+	"github.com/degache-go/degache/normalize"
+	"github.com/degache-go/degache/types"
+)
+
+// Normalize rewrites user input to plain ASCII before validation:
+// Arabic-Indic and Persian digits become ASCII digits, bidirectional marks and
+// zero-width characters are removed and Unicode spaces become ASCII spaces.
+// Validators apply it automatically unless strict mode is enabled.
+//
+// Parameters:
+//   - s: The raw input
+//
+// Returns:
+//   - string: the normalized input
+//
+// Example:
+//
+//	cin := Normalize("\u200f١٢٣٤٥٦٧٨") // "12345678"
+func Normalize(s string) string {
+	return normalize.String(s)
+}
+
+// normalizeInput normalizes s unless the options request strict validation
+func normalizeInput(s string, options []types.ValidationOptions) string {
+	if len(options) > 0 && options[0].Strict {
+		return s
+	}
+	return normalize.String(s)
+}
@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+)
+
+func TestValidatorsNormalizeInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func() bool
+		expected bool
+	}{
+		{"CIN Arabic-Indic digits", func() bool { return ValidateCIN("١٢٣٤٥٦٧٨") }, true},
+		{"CIN with RLM", func() bool { return ValidateCIN("\u200f12345678") }, true},
+		{"CIN strict", func() bool { return ValidateCIN("١٢٣٤٥٦٧٨", types.ValidationOptions{Strict: true}) }, false},
+		{"Phone Arabic-Indic digits", func() bool { return ValidatePhoneNumber("٢٠ ١٢٣ ٤٥٦") }, true},
+		{"Phone Persian digits", func() bool { return ValidatePhoneNumber("+۲۱۶۹۸۱۲۳۴۵۶") }, true},
+		{"Phone non-breaking spaces", func() bool { return ValidatePhoneNumber("20\u00a0123\u00a0456") }, true},
+		{"Phone strict", func() bool {
+			return ValidatePhoneNumber("٢٠١٢٣٤٥٦", types.PhoneNumberValidationOptions{Strict: true})
+		}, false},
+		{"Tax ID Arabic-Indic digits", func() bool { return ValidateTaxID("١٢٣٤٥٦٧A/P/M/٠٠٠") }, true},
+		{"RIB zero-width space", func() bool { return ValidateRIB("0123456789\u200b0123456789") }, true},
+		{"Postal code Persian digits", func() bool { return ValidatePostalCode("۱۰۰۰") }, true},
+		{"Postal code strict", func() bool { return ValidatePostalCode("\u00a01000", types.ValidationOptions{Strict: true}) }, false},
+		{"Car plate Arabic-Indic digits", func() bool { return ValidateCarPlate("١٢٣ تونس ٤٥٦٧") }, true},
+		{"Car plate strict", func() bool {
+			return ValidateCarPlate("١٢٣ تونس ٤٥٦٧", types.CarPlateValidationOptions{Strict: true})
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.validate(); result != tt.expected {
+				t.Errorf("result = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckNormalizedPosition(t *testing.T) {
+	// Positions refer to the normalized input: the RLM is removed before checking
+	verr := AsValidationError(CheckCIN("\u200f١٢٣٤٥٦٧x"))
+	if verr == nil || !errors.Is(verr, types.ErrInvalidCharacter) || verr.Position != 7 {
+		t.Errorf("CheckCIN() = %v, want invalid_character at position 7", verr)
+	}
+}
+
+func TestGettersNormalizeInput(t *testing.T) {
+	if info := GetCarrierInfo("٩٨ ١٢٣ ٤٥٦"); info == nil || info.Carrier.Name != "Tunisie Telecom" {
+		t.Errorf("GetCarrierInfo() = %v, want Tunisie Telecom", info)
+	}
+	if gov := GetGovernorateFromPostalCode("١٠٠٠"); gov == nil || gov.Name != "Tunis" {
+		t.Errorf("GetGovernorateFromPostalCode() = %v, want Tunis", gov)
+	}
+	if components, err := ExtractRIBComponents("٠١٢٣٤٥٦٧٨٩٠١٢٣٤٥٦٧٨٩"); err != nil || components["bankCode"] != "01" {
+		t.Errorf("ExtractRIBComponents() = %v, %v, want bankCode 01", components, err)
+	}
+}
@@ -1,20 +1,9 @@
 package validators
 
 import (
-	"regexp"
-	"strings"
-
-	"github.com/degache-go/degache/constants"
 	"github.com/degache-go/degache/types"
 )
 
-// Regular expressions for phone number validation
-var (
-	phoneRegex         = regexp.MustCompile(`^[2-9]\d{7}$`)
-	internationalRegex = regexp.MustCompile(`^\+216[2-9]\d{7}$`)
-	strictPhoneRegex   = regexp.MustCompile(`^(?:\+216)?[2-9]\d{7}$`)
-)
-
 // ValidatePhoneNumber validates a Tunisian phone number
 //
 // Parameters:
@@ -39,32 +28,12 @@ func ValidatePhoneNumber(phoneNumber string, options ...types.PhoneNumberValidat
 		opts = options[0]
 	}
 
-	// In strict mode, validate against the strict regex first
-	if opts.Strict && !strictPhoneRegex.MatchString(phoneNumber) {
-		return false
-	}
-
-	// Remove international prefix if present
-	normalizedNumber := strings.TrimPrefix(phoneNumber, "+216")
-
-	// Remove spaces and special characters if not in strict mode
+	// Outside strict mode, spaces and separators are ignored
 	if !opts.Strict {
-		normalizedNumber = regexp.MustCompile(`\D`).ReplaceAllString(normalizedNumber, "")
+		phoneNumber = Normalize(phoneNumber)
 	}
 
-	if !phoneRegex.MatchString(normalizedNumber) {
-		return false
-	}
-
-	// Check if the prefix is valid
-	prefix := string(normalizedNumber[0])
-	for _, validPrefix := range constants.ValidPrefixes {
-		if prefix == validPrefix {
-			return true
-		}
-	}
-
-	return false
+	return isPhoneNumber(phoneNumber, opts.Strict)
 }
 
 // GetCarrierInfo gets carrier information from a phone number
@@ -83,34 +52,12 @@ func ValidatePhoneNumber(phoneNumber string, options ...types.PhoneNumberValidat
 //	    fmt.Printf("Carrier: %s\n", info.Carrier.Name)
 //	}
 func GetCarrierInfo(phoneNumber string, options ...types.PhoneNumberValidationOptions) *types.CarrierInfo {
-	var opts types.PhoneNumberValidationOptions
-	if len(options) > 0 {
-		opts = options[0]
-	}
-
-	if !ValidatePhoneNumber(phoneNumber, opts) {
+	phone, err := types.ParsePhoneNumber(phoneNumber, options...)
+	if err != nil {
 		return nil
 	}
 
-	normalizedNumber := strings.TrimPrefix(phoneNumber, "+216")
-	if !opts.Strict {
-		normalizedNumber = regexp.MustCompile(`\D`).ReplaceAllString(normalizedNumber, "")
-	}
-
-	prefix := string(normalizedNumber[0])
-
-	for _, carrier := range constants.Carriers {
-		for _, carrierPrefix := range carrier.Prefixes {
-			if prefix == carrierPrefix {
-				return &types.CarrierInfo{
-					Carrier: carrier,
-					Prefix:  prefix,
-				}
-			}
-		}
-	}
-
-	return nil
+	return phone.Carrier()
 }
 
 // ValidatePhoneNumberWithDetails validates a phone number and returns detailed information
@@ -130,43 +77,24 @@ func GetCarrierInfo(phoneNumber string, options ...types.PhoneNumberValidationOp
 //	    fmt.Println("Invalid phone number:", msg)
 //	}
 func ValidatePhoneNumberWithDetails(phoneNumber string, options ...types.PhoneNumberValidationOptions) (bool, string) {
-	if phoneNumber == "" {
-		return false, "Phone number cannot be empty"
-	}
-
-	var opts types.PhoneNumberValidationOptions
-	if len(options) > 0 {
-		opts = options[0]
-	}
-
-	// In strict mode, validate against the strict regex first
-	if opts.Strict && !strictPhoneRegex.MatchString(phoneNumber) {
-		return false, "Phone number format is invalid in strict mode"
-	}
-
-	// Remove international prefix if present
-	normalizedNumber := strings.TrimPrefix(phoneNumber, "+216")
-
-	// Remove spaces and special characters if not in strict mode
-	if !opts.Strict {
-		normalizedNumber = regexp.MustCompile(`\D`).ReplaceAllString(normalizedNumber, "")
-	}
-
-	if len(normalizedNumber) != 8 {
-		return false, "Phone number must be exactly 8 digits"
-	}
-
-	if !phoneRegex.MatchString(normalizedNumber) {
-		return false, "Phone number must start with 2-9 and contain only digits"
-	}
-
-	// Check if the prefix is valid
-	prefix := string(normalizedNumber[0])
-	for _, validPrefix := range constants.ValidPrefixes {
-		if prefix == validPrefix {
-			return true, ""
-		}
-	}
+	return details(CheckPhoneNumber(phoneNumber, options...))
+}
 
-	return false, "Phone number prefix is not valid for Tunisian carriers"
+// CheckPhoneNumber validates a phone number and returns a structured error describing the failure
+//
+// Parameters:
+//   - phoneNumber: The phone number to validate
+//   - options: Validation options (optional)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckPhoneNumber("10123456")
+//	if verr := AsValidationError(err); verr != nil {
+//	    fmt.Println(verr.Code) // bad_prefix
+//	}
+func CheckPhoneNumber(phoneNumber string, options ...types.PhoneNumberValidationOptions) error {
+	return types.PhoneNumber(phoneNumber).Validate(options...)
 }
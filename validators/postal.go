@@ -1,20 +1,18 @@
 package validators
 
 import (
-	"regexp"
+	"strings"
 
 	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/types"
 )
 
-// postalCodeRegex is the regular expression for postal code validation
-// 4 digits
-var postalCodeRegex = regexp.MustCompile(`^\d{4}$`)
-
 // ValidatePostalCode validates a Tunisian postal code
 // A valid postal code is a 4-digit number
 //
 // Parameters:
 //   - postalCode: The postal code to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the postal code is valid, false otherwise
@@ -23,31 +21,17 @@ var postalCodeRegex = regexp.MustCompile(`^\d{4}$`)
 //
 //	isValid := ValidatePostalCode("1000") // returns true (Tunis)
 //	isValid := ValidatePostalCode("123")  // returns false (not 4 digits)
-func ValidatePostalCode(postalCode string) bool {
-	if postalCode == "" {
-		return false
-	}
-
-	if !postalCodeRegex.MatchString(postalCode) {
-		return false
-	}
-
-	// Check if postal code exists in governorates
-	for _, governorate := range constants.Governorates {
-		if governorate.PostalCode == postalCode {
-			return true
-		}
-	}
-
-	// If not found in main governorates, accept any 4-digit code
-	// as there might be sub-regions not listed
-	return true
+func ValidatePostalCode(postalCode string, options ...types.ValidationOptions) bool {
+	// Any 4-digit code is accepted, not only the main governorates listed
+	// in constants, as there might be sub-regions not listed
+	return isPostalCode(normalizeInput(postalCode, options))
 }
 
 // ValidatePostalCodeWithDetails validates a postal code and returns detailed information
 //
 // Parameters:
 //   - postalCode: The postal code to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the postal code is valid
@@ -59,20 +43,28 @@ func ValidatePostalCode(postalCode string) bool {
 //	if !valid {
 //	    fmt.Println("Invalid postal code:", msg)
 //	}
-func ValidatePostalCodeWithDetails(postalCode string) (bool, string) {
-	if postalCode == "" {
-		return false, "Postal code cannot be empty"
-	}
-
-	if len(postalCode) != 4 {
-		return false, "Postal code must be exactly 4 digits"
-	}
-
-	if !postalCodeRegex.MatchString(postalCode) {
-		return false, "Postal code must contain only digits"
-	}
+func ValidatePostalCodeWithDetails(postalCode string, options ...types.ValidationOptions) (bool, string) {
+	return details(CheckPostalCode(postalCode, options...))
+}
 
-	return true, ""
+// CheckPostalCode validates a postal code and returns a structured error describing the failure.
+// Outside strict mode, error positions refer to the normalized input.
+//
+// Parameters:
+//   - postalCode: The postal code to validate
+//   - options: Validation options (optional, Strict disables input normalization)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckPostalCode("10a0")
+//	if errors.Is(err, types.ErrInvalidCharacter) {
+//	    fmt.Println("Postal codes contain only digits")
+//	}
+func CheckPostalCode(postalCode string, options ...types.ValidationOptions) error {
+	return types.PostalCode(normalizeInput(postalCode, options)).Validate()
 }
 
 // GetGovernorateFromPostalCode gets governorate information from a postal code
@@ -90,16 +82,36 @@ func ValidatePostalCodeWithDetails(postalCode string) (bool, string) {
 //	    fmt.Printf("Governorate: %s, Region: %s\n", gov.Name, gov.Region)
 //	}
 func GetGovernorateFromPostalCode(postalCode string) *constants.Governorate {
-	if !ValidatePostalCode(postalCode) {
+	postalCode = Normalize(postalCode)
+	if !ValidatePostalCode(postalCode, types.ValidationOptions{Strict: true}) {
 		return nil
 	}
 
+	return types.PostalCode(postalCode).Governorate()
+}
+
+// GetGovernorateByName gets governorate information from its name
+// Names are matched case-insensitively, after normalization (see Normalize).
+//
+// Parameters:
+//   - name: The governorate name (e.g. "Sfax")
+//
+// Returns:
+//   - *constants.Governorate: governorate information or nil if not found
+//
+// Example:
+//
+//	gov := GetGovernorateByName("sfax")
+//	if gov != nil {
+//	    fmt.Println(gov.PostalCode) // 3000
+//	}
+func GetGovernorateByName(name string) *constants.Governorate {
+	name = strings.TrimSpace(Normalize(name))
 	for _, governorate := range constants.Governorates {
-		if governorate.PostalCode == postalCode {
+		if strings.EqualFold(governorate.Name, name) {
 			return &governorate
 		}
 	}
-
 	return nil
 }
 
@@ -0,0 +1,278 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/degache-go/degache/types"
+)
+
+// ErrUnknownValidator is returned when no validator is registered under a name
+var ErrUnknownValidator = errors.New("unknown validator")
+
+// Options contains per-field options passed to a Validator
+type Options struct {
+	// Strict enforces strict format validation where the validator supports it
+	Strict bool `json:"strict,omitempty"`
+	// Params holds validator-specific settings (e.g. "type" for car plates)
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Validator validates one kind of Tunisian data
+type Validator interface {
+	// Name returns the name the validator is registered under (e.g. "cin")
+	Name() string
+	// Check validates value and returns nil if it is valid.
+	// Failures should preferably be reported as *types.ValidationError.
+	Check(value string, opts Options) error
+}
+
+// Normalizer is implemented by validators that can produce the canonical form of a valid value
+type Normalizer interface {
+	Normalize(value string, opts Options) (string, error)
+}
+
+// Describer is implemented by validators that can extract metadata from a valid value
+type Describer interface {
+	Describe(value string, opts Options) map[string]string
+}
+
+// Analyzer is implemented by validators that can validate a value, produce its
+// canonical form and extract its metadata in a single pass. Reports use it
+// instead of calling Check, Normalize and Describe in turn.
+type Analyzer interface {
+	Analyze(value string, opts Options) (normalized string, metadata map[string]string, err error)
+}
+
+// funcValidator adapts a function to the Validator interface
+type funcValidator struct {
+	name  string
+	check func(value string, opts Options) error
+}
+
+func (v funcValidator) Name() string {
+	return v.name
+}
+
+func (v funcValidator) Check(value string, opts Options) error {
+	return v.check(value, opts)
+}
+
+// NewValidator creates a Validator from a name and a check function
+//
+// Parameters:
+//   - name: The name to register the validator under
+//   - check: The function validating a value
+//
+// Returns:
+//   - Validator: the validator
+//
+// Example:
+//
+//	employee := NewValidator("employee", func(value string, opts Options) error {
+//	    if !strings.HasPrefix(value, "EMP-") {
+//	        return errors.New("employee numbers start with EMP-")
+//	    }
+//	    return nil
+//	})
+func NewValidator(name string, check func(value string, opts Options) error) Validator {
+	return funcValidator{name: name, check: check}
+}
+
+// Registry holds validators indexed by name. Names are matched case-insensitively.
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	validators map[string]Validator
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{validators: make(map[string]Validator)}
+}
+
+// Register adds a validator to the registry
+//
+// Parameters:
+//   - v: The validator to register
+//
+// Returns:
+//   - error: error if the name is empty or already registered
+func (r *Registry) Register(v Validator) error {
+	key := strings.ToLower(v.Name())
+	if key == "" {
+		return errors.New("validator name cannot be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.validators[key]; exists {
+		return fmt.Errorf("validator %q already registered", v.Name())
+	}
+	r.validators[key] = v
+	return nil
+}
+
+// Unregister removes the validator registered under name
+//
+// Returns:
+//   - bool: true if a validator was registered under name
+func (r *Registry) Unregister(name string) bool {
+	key := strings.ToLower(name)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exists := r.validators[key]
+	delete(r.validators, key)
+	return exists
+}
+
+// Lookup returns the validator registered under name
+func (r *Registry) Lookup(name string) (Validator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.validators[strings.ToLower(name)]
+	return v, ok
+}
+
+// Names returns the names of all registered validators, sorted
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.validators))
+	for _, v := range r.validators {
+		names = append(names, v.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Check validates value with the validator registered under name
+//
+// Parameters:
+//   - name: The validator name
+//   - value: The value to validate
+//   - opts: Validation options
+//
+// Returns:
+//   - error: nil if valid, ErrUnknownValidator if no validator is registered, or the validation error
+func (r *Registry) Check(name, value string, opts Options) error {
+	v, ok := r.Lookup(name)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownValidator, name)
+	}
+	return v.Check(value, opts)
+}
+
+// CheckAll validates every field of data with the validator registered under the field name
+//
+// Parameters:
+//   - data: Field names mapped to values
+//   - options: Per-field options (optional, may be nil)
+//
+// Returns:
+//   - map[string]error: the result for every field of data (nil when valid).
+//     Fields without a registered validator map to an error wrapping ErrUnknownValidator.
+func (r *Registry) CheckAll(data map[string]string, options map[string]Options) map[string]error {
+	results := make(map[string]error, len(data))
+	for field, value := range data {
+		results[field] = r.Check(field, value, options[field])
+	}
+	return results
+}
+
+// Report validates every field of data and builds a report with normalized values and metadata
+//
+// Parameters:
+//   - data: Field names mapped to values
+//   - options: Per-field options (optional, may be nil)
+//
+// Returns:
+//   - types.ValidationReport: the report; Valid is true only if every field is valid
+//
+// Example:
+//
+//	report := DefaultRegistry.Report(map[string]string{"phone": "20 123 456"}, nil)
+//	fmt.Println(report.Fields["phone"].Normalized)          // +21620123456
+//	fmt.Println(report.Fields["phone"].Metadata["carrier"]) // Ooredoo Tunisia
+func (r *Registry) Report(data map[string]string, options map[string]Options) types.ValidationReport {
+	report := types.ValidationReport{
+		Valid:  true,
+		Fields: make(map[string]types.FieldReport, len(data)),
+	}
+
+	for field, value := range data {
+		fieldReport := r.reportField(field, value, options[field])
+		report.Valid = report.Valid && fieldReport.Valid
+		report.Fields[field] = fieldReport
+	}
+
+	return report
+}
+
+// reportField builds the report of a single field
+func (r *Registry) reportField(field, value string, opts Options) types.FieldReport {
+	v, ok := r.Lookup(field)
+	if !ok {
+		return types.FieldReport{
+			Error: newValidationError(field, types.ErrUnknownField, -1, "", fmt.Sprintf("No validator registered for %q", field)),
+		}
+	}
+
+	if a, ok := v.(Analyzer); ok {
+		normalized, metadata, err := a.Analyze(value, opts)
+		if err != nil {
+			return failedField(field, err)
+		}
+		return types.FieldReport{Valid: true, Normalized: normalized, Metadata: metadata}
+	}
+
+	if err := v.Check(value, opts); err != nil {
+		return failedField(field, err)
+	}
+
+	fieldReport := types.FieldReport{Valid: true, Normalized: value}
+	if n, ok := v.(Normalizer); ok {
+		if normalized, err := n.Normalize(value, opts); err == nil {
+			fieldReport.Normalized = normalized
+		}
+	}
+	if d, ok := v.(Describer); ok {
+		fieldReport.Metadata = d.Describe(value, opts)
+	}
+
+	return fieldReport
+}
+
+// failedField builds the report of a field that failed validation
+func failedField(field string, err error) types.FieldReport {
+	verr := AsValidationError(err)
+	if verr == nil {
+		verr = newValidationError(field, types.ErrInvalid, -1, "", err.Error())
+	}
+	return types.FieldReport{Error: verr}
+}
+
+// DefaultRegistry contains the built-in validators and any validator added with Register
+var DefaultRegistry = newDefaultRegistry()
+
+// Register adds a validator to DefaultRegistry
+//
+// Example:
+//
+//	err := validators.Register(validators.NewValidator("employee", checkEmployeeNumber))
+func Register(v Validator) error {
+	return DefaultRegistry.Register(v)
+}
+
+// Lookup returns the validator registered under name in DefaultRegistry
+func Lookup(name string) (Validator, bool) {
+	return DefaultRegistry.Lookup(name)
+}
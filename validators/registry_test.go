@@ -0,0 +1,154 @@
+package validators
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	employee := NewValidator("employee", func(value string, _ Options) error {
+		if !strings.HasPrefix(value, "EMP-") {
+			return errors.New("employee numbers start with EMP-")
+		}
+		return nil
+	})
+
+	if err := r.Register(employee); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+	if err := r.Register(employee); err == nil {
+		t.Error("Register() of a duplicate name should fail")
+	}
+	if _, ok := r.Lookup("EMPLOYEE"); !ok {
+		t.Error("Lookup() should be case-insensitive")
+	}
+
+	results := r.CheckAll(map[string]string{
+		"employee": "EMP-42",
+		"badge":    "B-1",
+	}, nil)
+	if results["employee"] != nil {
+		t.Errorf("CheckAll()[employee] = %v, want nil", results["employee"])
+	}
+	if !errors.Is(results["badge"], ErrUnknownValidator) {
+		t.Errorf("CheckAll()[badge] = %v, want ErrUnknownValidator", results["badge"])
+	}
+
+	if !r.Unregister("Employee") {
+		t.Error("Unregister() should report the removed validator")
+	}
+	if _, ok := r.Lookup("employee"); ok || r.Unregister("employee") {
+		t.Error("Unregister() should remove the validator")
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		opts  Options
+		valid bool
+	}{
+		{"cin", "12345678", Options{}, true},
+		{"phone", "20 123 456", Options{}, true},
+		{"phone", "20 123 456", Options{Strict: true}, false},
+		{"taxID", "1234567A/P/M/000", Options{}, true},
+		{"rib", "01234567890123456789", Options{}, true},
+		{"postal", "1000", Options{}, true},
+		{"carPlate", "RS 123 تونس", Options{Params: map[string]string{"type": "special"}}, true},
+		{"carPlate", "RS 123 تونس", Options{}, false},
+	}
+
+	for _, tt := range tests {
+		err := DefaultRegistry.Check(tt.name, tt.value, tt.opts)
+		if (err == nil) != tt.valid {
+			t.Errorf("DefaultRegistry.Check(%q, %q, %+v) = %v, want valid=%v", tt.name, tt.value, tt.opts, err, tt.valid)
+		}
+	}
+}
+
+// countingAnalyzer counts the calls made to build a report
+type countingAnalyzer struct {
+	checks, analyses int
+}
+
+func (v *countingAnalyzer) Name() string { return "counted" }
+
+func (v *countingAnalyzer) Check(string, Options) error {
+	v.checks++
+	return nil
+}
+
+func (v *countingAnalyzer) Analyze(value string, _ Options) (string, map[string]string, error) {
+	v.analyses++
+	return strings.ToUpper(value), map[string]string{"length": "3"}, nil
+}
+
+func TestReportAnalyzesOnce(t *testing.T) {
+	r := NewRegistry()
+	v := &countingAnalyzer{}
+	if err := r.Register(v); err != nil {
+		t.Fatalf("Register() unexpected error: %v", err)
+	}
+
+	report := r.Report(map[string]string{"counted": "abc"}, nil)
+	field := report.Fields["counted"]
+	if !field.Valid || field.Normalized != "ABC" || field.Metadata["length"] != "3" {
+		t.Errorf("Report() field = %+v, want the analysis", field)
+	}
+	if v.checks != 0 || v.analyses != 1 {
+		t.Errorf("Report() made %d checks and %d analyses, want 0 and 1", v.checks, v.analyses)
+	}
+}
+
+func TestBuiltinAnalyze(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		opts       Options
+		normalized string
+		metadata   map[string]string
+	}{
+		{"cin", " ١٢٣٤٥٦٧٨ ", Options{}, "12345678", nil},
+		{"phone", "20 123 456", Options{}, "+21620123456", map[string]string{"carrier": "Ooredoo Tunisia", "prefix": "2"}},
+		{"taxID", "1234567A/P/M/000", Options{}, "1234567A/P/M/000",
+			map[string]string{"number": "1234567", "type1": "A", "type2": "P", "type3": "M", "sequence": "000"}},
+		{"rib", "01234567890123456789", Options{}, "01234567890123456789",
+			map[string]string{"bankCode": "01", "branchCode": "234", "accountNumber": "5678901234567", "key": "89", "bank": "Banque Centrale de Tunisie"}},
+		{"postal", "3000", Options{}, "3000", map[string]string{"governorate": "Sfax", "region": "Center"}},
+		{"carPlate", "123  تونس  4567", Options{}, "123 تونس 4567",
+			map[string]string{"type": "standard", "prefix": "123", "region": "تونس", "suffix": "4567"}},
+	}
+
+	for _, tt := range tests {
+		v, _ := DefaultRegistry.Lookup(tt.name)
+		a, ok := v.(Analyzer)
+		if !ok {
+			t.Fatalf("%s validator does not implement Analyzer", tt.name)
+		}
+
+		normalized, metadata, err := a.Analyze(tt.value, tt.opts)
+		if err != nil || normalized != tt.normalized || !maps.Equal(metadata, tt.metadata) {
+			t.Errorf("%s.Analyze(%q) = %q, %v, %v, want %q, %v", tt.name, tt.value, normalized, metadata, err, tt.normalized, tt.metadata)
+		}
+
+		// Normalize and Describe agree with Analyze
+		if n, _ := v.(Normalizer).Normalize(tt.value, tt.opts); n != normalized {
+			t.Errorf("%s.Normalize(%q) = %q, want %q", tt.name, tt.value, n, normalized)
+		}
+		if d := v.(Describer).Describe(tt.value, tt.opts); !maps.Equal(d, metadata) {
+			t.Errorf("%s.Describe(%q) = %v, want %v", tt.name, tt.value, d, metadata)
+		}
+	}
+
+	for _, tt := range tests {
+		v, _ := DefaultRegistry.Lookup(tt.name)
+		_, _, err := v.(Analyzer).Analyze("x", Options{})
+		if err == nil || err.Error() != v.Check("x", Options{}).Error() {
+			t.Errorf("%s.Analyze(\"x\") error = %v, want the Check error", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/internal/scan"
+)
+
+// The bool validators are implemented with hand-written byte scanners rather
+// than regular expressions: they do not allocate, valid or not, which matters
+// when validating millions of rows. The character rules they share with the
+// Validate methods of package types live in internal/scan. The Check*
+// functions remain the source of detailed errors;
+// TestScannersMatchCheckFunctions keeps both in agreement.
+
+// carPlateRegion is the region written on Tunisian car plates
+const carPlateRegion = "تونس"
+
+// isCIN reports whether s is 8 digits starting with 0 or 1
+func isCIN(s string) bool {
+	return len(s) == 8 && (s[0] == '0' || s[0] == '1') && scan.IsDigits(s)
+}
+
+// isTaxID reports whether s follows scan.TaxIDLayout
+func isTaxID(s string) bool {
+	return len(s) == len(scan.TaxIDLayout) && scan.LayoutMismatch(s, scan.TaxIDLayout) < 0
+}
+
+// isRIB reports whether s is 20 digits starting with a known bank code
+func isRIB(s string) bool {
+	if len(s) != 20 || !scan.IsDigits(s) {
+		return false
+	}
+	_, exists := constants.Banks[s[:2]]
+	return exists
+}
+
+// isPostalCode reports whether s is 4 digits
+func isPostalCode(s string) bool {
+	return len(s) == 4 && scan.IsDigits(s)
+}
+
+// isPhoneNumber reports whether s is 8 digits with a valid carrier prefix,
+// optionally preceded by +216. Outside strict mode, non-digit characters
+// after the country code are ignored.
+func isPhoneNumber(s string, strict bool) bool {
+	s = strings.TrimPrefix(s, constants.CountryCode)
+
+	var first byte
+	count := 0
+	for i := 0; i < len(s); i++ {
+		if !scan.IsDigit(s[i]) {
+			if strict {
+				return false
+			}
+			continue
+		}
+		if count == 0 {
+			first = s[i]
+		}
+		count++
+	}
+
+	return count == 8 && isValidPrefix(first)
+}
+
+// isValidPrefix reports whether c is one of constants.ValidPrefixes
+func isValidPrefix(c byte) bool {
+	for _, prefix := range constants.ValidPrefixes {
+		if len(prefix) == 1 && prefix[0] == c {
+			return true
+		}
+	}
+	return false
+}
+
+// plateScanner consumes a car plate from left to right
+type plateScanner struct {
+	s      string
+	strict bool
+}
+
+// digits consumes n digits and returns them
+func (p *plateScanner) digits(n int) (string, bool) {
+	if len(p.s) < n || !scan.IsDigits(p.s[:n]) {
+		return "", false
+	}
+	digits := p.s[:n]
+	p.s = p.s[n:]
+	return digits, true
+}
+
+// literal consumes lit
+func (p *plateScanner) literal(lit string) bool {
+	if !strings.HasPrefix(p.s, lit) {
+		return false
+	}
+	p.s = p.s[len(lit):]
+	return true
+}
+
+// space consumes the separator between two parts of the plate:
+// exactly one space in strict mode, any run of whitespace otherwise
+func (p *plateScanner) space() bool {
+	if p.strict {
+		return p.literal(" ")
+	}
+	p.s = strings.TrimLeft(p.s, " \t\n\f\r")
+	return true
+}
+
+// matchStandardPlate matches "XXX تونس XXXX" and returns its prefix and suffix digits
+func matchStandardPlate(s string, strict bool) (prefix, suffix string, ok bool) {
+	p := plateScanner{s: s, strict: strict}
+
+	prefix, ok = p.digits(3)
+	if !ok || !p.space() || !p.literal(carPlateRegion) || !p.space() {
+		return "", "", false
+	}
+	suffix, ok = p.digits(4)
+	if !ok || p.s != "" {
+		return "", "", false
+	}
+	return prefix, suffix, true
+}
+
+// matchSpecialPlate matches "RS XXX تونس" and returns its digits
+func matchSpecialPlate(s string, strict bool) (number string, ok bool) {
+	p := plateScanner{s: s, strict: strict}
+
+	if !p.literal("RS") || !p.space() {
+		return "", false
+	}
+	number, ok = p.digits(3)
+	if !ok || !p.space() || !p.literal(carPlateRegion) || p.s != "" {
+		return "", false
+	}
+	return number, true
+}
+
+// normalizeCarPlate normalizes a car plate (see Normalize) and replaces runs of spaces with a single space
+func normalizeCarPlate(carPlate string) string {
+	s := Normalize(carPlate)
+	if !strings.Contains(s, "  ") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == ' ' && i > 0 && s[i-1] == ' ' {
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,170 @@
+package validators
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/types"
+)
+
+// scanCorpus contains valid and invalid inputs for every validator
+var scanCorpus = []string{
+	"", " ", "12345678", "01234567", "22345678", "1234567", "123456789", "1234567a", "١٢٣٤٥٦٧٨",
+	"20123456", "+21620123456", "20 123 456", "+216 20 123 456", "10123456", "2012345", "+216201234567",
+	"20-123-456", "20a123456", "+216+20123456", "٢٠ ١٢٣ ٤٥٦", "\u200f98123456",
+	"1234567A/P/M/000", "1234567a/P/M/000", "123456A/P/M/000", "1234567A-P/M/000", "1234567A/P/M/00",
+	"01234567890123456789", "99234567890123456789", "0123456789012345678", "0123456789012345678x",
+	"1000", "100", "10000", "10a0", "۱۰۰۰",
+	"123 تونس 4567", "123تونس4567", "123  تونس  4567", " 123 تونس 4567 ", "123 تونس 456",
+	"RS 123 تونس", "RS123تونس", "RS 12 تونس", "RS 123 تونس 4567", "123 4567", "abc تونس defg",
+}
+
+func TestScannersMatchCheckFunctions(t *testing.T) {
+	for _, strict := range []bool{false, true} {
+		opts := types.ValidationOptions{Strict: strict}
+		phoneOpts := types.PhoneNumberValidationOptions{Strict: strict}
+
+		for _, s := range scanCorpus {
+			pairs := []struct {
+				name  string
+				valid bool
+				err   error
+			}{
+				{"CIN", ValidateCIN(s, opts), CheckCIN(s, opts)},
+				{"PhoneNumber", ValidatePhoneNumber(s, phoneOpts), CheckPhoneNumber(s, phoneOpts)},
+				{"TaxID", ValidateTaxID(s, opts), CheckTaxID(s, opts)},
+				{"RIB", ValidateRIB(s, opts), CheckRIB(s, opts)},
+				{"PostalCode", ValidatePostalCode(s, opts), CheckPostalCode(s, opts)},
+			}
+			for _, plateType := range []string{"standard", "special"} {
+				plateOpts := types.CarPlateValidationOptions{Type: plateType, Strict: strict}
+				pairs = append(pairs, struct {
+					name  string
+					valid bool
+					err   error
+				}{"CarPlate/" + plateType, ValidateCarPlate(s, plateOpts), CheckCarPlate(s, plateOpts)})
+			}
+
+			for _, p := range pairs {
+				if p.valid != (p.err == nil) {
+					t.Errorf("Validate%s(%q, strict=%v) = %v, but Check%s returned %v",
+						p.name, s, strict, p.valid, p.name, p.err)
+				}
+			}
+		}
+	}
+}
+
+func TestValidatorsDoNotAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func()
+	}{
+		{"CIN", func() { ValidateCIN("12345678"); ValidateCIN("1234567a") }},
+		{"PhoneNumber", func() { ValidatePhoneNumber("+216 20 123 456"); ValidatePhoneNumber("10123456") }},
+		{"PhoneNumberStrict", func() {
+			ValidatePhoneNumber("+21620123456", types.PhoneNumberValidationOptions{Strict: true})
+		}},
+		{"TaxID", func() { ValidateTaxID("1234567A/P/M/000"); ValidateTaxID("invalid") }},
+		{"RIB", func() { ValidateRIB("01234567890123456789"); ValidateRIB("99234567890123456789") }},
+		{"PostalCode", func() { ValidatePostalCode("1000"); ValidatePostalCode("10a0") }},
+		{"CarPlate", func() { ValidateCarPlate("123  تونس 4567"); ValidateCarPlate("123 4567") }},
+		{"CarPlateSpecial", func() {
+			ValidateCarPlate("RS 123 تونس", types.CarPlateValidationOptions{Type: "special"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.validate); allocs != 0 {
+				t.Errorf("%s allocated %v times per run, want 0", tt.name, allocs)
+			}
+		})
+	}
+}
+
+func TestNormalizeCarPlate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"123 تونس 4567", "123 تونس 4567"},
+		{"  123   تونس\t4567 ", "123 تونس 4567"},
+		{"RS\u00a0\u00a0١٢٣ تونس", "RS 123 تونس"},
+	}
+
+	for _, tt := range tests {
+		if result := normalizeCarPlate(tt.input); result != tt.expected {
+			t.Errorf("normalizeCarPlate(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+// The regular expression implementations replaced by byte scanners, kept to
+// check that both accept the same inputs; BenchmarkScannersVsRegex in the root
+// package measures the gain. They take
+// normalized input and are built from the same constants as the validators.
+var (
+	regexCIN           = regexp.MustCompile(`^[01]\d{7}$`)
+	regexPhone         = regexp.MustCompile(`^[` + strings.Join(constants.ValidPrefixes, "") + `]\d{7}$`)
+	regexTaxID         = regexp.MustCompile(`^\d{7}[A-Z]/[A-Z]/[A-Z]/\d{3}$`)
+	regexRIB           = regexp.MustCompile(`^\d{20}$`)
+	regexPostalCode    = regexp.MustCompile(`^\d{4}$`)
+	regexStandardPlate = regexp.MustCompile(`^\d{3}\s*` + carPlateRegion + `\s*\d{4}$`)
+)
+
+func regexValidateCIN(cin string) bool {
+	return regexCIN.MatchString(cin)
+}
+
+func regexValidatePhoneNumber(phoneNumber string) bool {
+	normalized := strings.TrimPrefix(phoneNumber, constants.CountryCode)
+	normalized = regexp.MustCompile(`\D`).ReplaceAllString(normalized, "")
+	return regexPhone.MatchString(normalized)
+}
+
+func regexValidateTaxID(taxID string) bool {
+	return regexTaxID.MatchString(taxID)
+}
+
+func regexValidateRIB(rib string) bool {
+	if !regexRIB.MatchString(rib) {
+		return false
+	}
+	_, exists := constants.Banks[rib[:2]]
+	return exists
+}
+
+func regexValidatePostalCode(postalCode string) bool {
+	return regexPostalCode.MatchString(postalCode)
+}
+
+func regexValidateCarPlate(carPlate string) bool {
+	normalized := regexp.MustCompile(`\s+`).ReplaceAllString(strings.TrimSpace(carPlate), " ")
+	return regexStandardPlate.MatchString(normalized)
+}
+
+func TestScannersMatchRegexImplementation(t *testing.T) {
+	validators := []struct {
+		name    string
+		regex   func(string) bool
+		scanner func(string) bool
+	}{
+		{"CIN", regexValidateCIN, func(s string) bool { return ValidateCIN(s) }},
+		{"PhoneNumber", regexValidatePhoneNumber, func(s string) bool { return ValidatePhoneNumber(s) }},
+		{"TaxID", regexValidateTaxID, func(s string) bool { return ValidateTaxID(s) }},
+		{"RIB", regexValidateRIB, func(s string) bool { return ValidateRIB(s) }},
+		{"PostalCode", regexValidatePostalCode, func(s string) bool { return ValidatePostalCode(s) }},
+		{"CarPlate", regexValidateCarPlate, func(s string) bool { return ValidateCarPlate(s) }},
+	}
+
+	for _, v := range validators {
+		for _, input := range scanCorpus {
+			if regex, scanner := v.regex(Normalize(input)), v.scanner(input); regex != scanner {
+				t.Errorf("Validate%s(%q) = %v, regular expression implementation = %v", v.name, input, scanner, regex)
+			}
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// structTag is the struct tag key read by ValidateStruct
+const structTag = "degache"
+
+// FieldError is the validation failure of one struct field
+type FieldError struct {
+	// Path locates the field from the validated struct (e.g. "Employees[3].Phone")
+	Path string
+	// Err is the validation error, usually a *types.ValidationError
+	Err error
+}
+
+// Error implements the error interface
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying validation error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// StructError collects every field failure found by ValidateStruct
+type StructError []*FieldError
+
+// Error implements the error interface
+func (e StructError) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the field errors so that errors.Is and errors.As inspect each of them
+func (e StructError) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fieldErr := range e {
+		errs[i] = fieldErr
+	}
+	return errs
+}
+
+// tagRule is a parsed `degache:"..."` struct tag
+type tagRule struct {
+	name      string
+	omitEmpty bool
+	opts      Options
+}
+
+// parseTag parses a tag such as "carplate,strict,type=special"
+func parseTag(tag string) tagRule {
+	parts := strings.Split(tag, ",")
+	rule := tagRule{name: strings.TrimSpace(parts[0])}
+
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "strict":
+			rule.opts.Strict = true
+		case part == "omitempty":
+			rule.omitEmpty = true
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(part, "=")
+			if rule.opts.Params == nil {
+				rule.opts.Params = make(map[string]string)
+			}
+			rule.opts.Params[key] = value
+		}
+	}
+
+	return rule
+}
+
+// ValidateStruct validates the fields of a struct using `degache` struct tags
+// and the validators of DefaultRegistry. See Registry.ValidateStruct.
+//
+// Example:
+//
+//	type Employee struct {
+//	    CIN    types.CIN         `degache:"cin"`
+//	    Phone  types.PhoneNumber `degache:"phone,strict"`
+//	    Car    string            `degache:"carplate,type=special,omitempty"`
+//	    Postal string            `degache:"postal,omitempty"`
+//	}
+//
+//	if err := ValidateStruct(employee); err != nil {
+//	    var structErr StructError
+//	    if errors.As(err, &structErr) {
+//	        for _, fieldErr := range structErr {
+//	            fmt.Println(fieldErr.Path, fieldErr.Err)
+//	        }
+//	    }
+//	}
+func ValidateStruct(v any) error {
+	return DefaultRegistry.ValidateStruct(v)
+}
+
+// ValidateStruct validates the fields of a struct using `degache` struct tags
+//
+// The tag holds the validator name followed by options:
+//   - strict: enables strict validation
+//   - omitempty: skips the field when it is empty (or a nil pointer)
+//   - key=value: validator-specific parameters (e.g. type=special for car plates)
+//
+// Tagged fields must be strings (or string-based types such as types.CIN), pointers
+// to strings, or slices, arrays and maps of those. Untagged structs, pointers, slices,
+// arrays and maps are walked recursively. Untagged embedded structs are walked
+// even when their type is unexported, and their fields are reported under the
+// promoted name ("CIN" rather than "Person.CIN"). A tag of "-" skips the field.
+//
+// Parameters:
+//   - v: The struct, or pointer to struct, to validate
+//
+// Returns:
+//   - error: nil if every field is valid, a StructError listing every failure,
+//     or a plain error if v is not a struct or a tag is misconfigured
+func (r *Registry) ValidateStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return errors.New("validators: ValidateStruct called with a nil value")
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validators: ValidateStruct expects a struct, got %T", v)
+	}
+
+	w := &structWalker{registry: r, visiting: make(map[visit]bool)}
+	if err := w.walkStruct(rv, ""); err != nil {
+		return err
+	}
+
+	if len(w.failures) > 0 {
+		return w.failures
+	}
+	return nil
+}
+
+// structWalker walks a value and collects field failures
+type structWalker struct {
+	registry *Registry
+	// visiting holds the pointers, slices and maps on the current path, to
+	// stop at cycles. A value shared by several fields is validated on each of
+	// their paths.
+	visiting map[visit]bool
+	failures StructError
+}
+
+// visit identifies a pointer, slice or map by its type, address and length:
+// a pointer to a struct and a pointer to its first field have the same
+// address, and so do a slice and its shorter reslices
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+// enter marks v as being on the current path. It reports false when v is nil
+// or already on the path; otherwise the caller must call leave when done.
+func (w *structWalker) enter(v reflect.Value) (visit, bool) {
+	if v.IsNil() {
+		return visit{}, false
+	}
+	key := visit{typ: v.Type(), ptr: v.Pointer()}
+	if v.Kind() != reflect.Pointer {
+		key.len = v.Len()
+	}
+	if w.visiting[key] {
+		return visit{}, false
+	}
+	w.visiting[key] = true
+	return key, true
+}
+
+// leave removes key from the current path
+func (w *structWalker) leave(key visit) {
+	delete(w.visiting, key)
+}
+
+// walkStruct validates tagged fields and recurses into untagged ones
+func (w *structWalker) walkStruct(v reflect.Value, path string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get(structTag)
+		rule := parseTag(tag)
+
+		// Fields promoted from embedded structs are validated under their
+		// own name, as encoding/json does, even if the embedded type is unexported
+		embedded := sf.Anonymous && rule.name == "" && isStruct(sf.Type)
+		if (!sf.IsExported() && !embedded) || tag == "-" {
+			continue
+		}
+
+		fieldPath := sf.Name
+		if embedded {
+			fieldPath = path
+		} else if path != "" {
+			fieldPath = path + "." + sf.Name
+		}
+
+		var err error
+		if rule.name != "" {
+			err = w.checkTagged(v.Field(i), fieldPath, rule)
+		} else {
+			err = w.walkValue(v.Field(i), fieldPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isStruct reports whether t is a struct or a pointer to a struct
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// walkValue recurses into untagged containers
+func (w *structWalker) walkValue(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		key, ok := w.enter(v)
+		if !ok {
+			return nil
+		}
+		defer w.leave(key)
+		return w.walkValue(v.Elem(), path)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return w.walkValue(v.Elem(), path)
+	case reflect.Struct:
+		return w.walkStruct(v, path)
+	case reflect.Slice, reflect.Map:
+		key, ok := w.enter(v)
+		if !ok {
+			return nil
+		}
+		defer w.leave(key)
+		return w.walkElems(v, path)
+	case reflect.Array:
+		return w.walkElems(v, path)
+	}
+
+	return nil
+}
+
+// walkElems recurses into the elements of an untagged slice, array or map
+func (w *structWalker) walkElems(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walkValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(v) {
+			if err := w.walkValue(v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTagged validates a tagged field
+func (w *structWalker) checkTagged(v reflect.Value, path string, rule tagRule) error {
+	switch v.Kind() {
+	case reflect.String:
+		return w.check(v.String(), path, rule)
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return w.check("", path, rule)
+		}
+		return w.checkTagged(v.Elem(), path, rule)
+	case reflect.Slice, reflect.Map:
+		key, ok := w.enter(v)
+		if !ok {
+			return nil
+		}
+		defer w.leave(key)
+		return w.checkElems(v, path, rule)
+	case reflect.Array:
+		return w.checkElems(v, path, rule)
+	default:
+		return fmt.Errorf("validators: field %s: cannot apply %q validator to %s", path, rule.name, v.Type())
+	}
+}
+
+// checkElems validates the elements of a tagged slice, array or map
+func (w *structWalker) checkElems(v reflect.Value, path string, rule tagRule) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.checkTagged(v.Index(i), fmt.Sprintf("%s[%d]", path, i), rule); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(v) {
+			if err := w.checkTagged(v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key), rule); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// check validates a single value and records a failure
+func (w *structWalker) check(value, path string, rule tagRule) error {
+	if value == "" && rule.omitEmpty {
+		return nil
+	}
+
+	err := w.registry.Check(rule.name, value, rule.opts)
+	if errors.Is(err, ErrUnknownValidator) {
+		return fmt.Errorf("validators: field %s: %w", path, err)
+	}
+	if err != nil {
+		w.failures = append(w.failures, &FieldError{Path: path, Err: err})
+	}
+
+	return nil
+}
+
+// sortedMapKeys returns the keys of a map in a deterministic order
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
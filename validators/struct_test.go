@@ -0,0 +1,234 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/degache-go/degache/types"
+)
+
+type testAddress struct {
+	Street     string
+	PostalCode types.PostalCode `degache:"postal"`
+}
+
+type testEmployee struct {
+	CIN      types.CIN         `degache:"cin"`
+	Phone    types.PhoneNumber `degache:"phone,strict"`
+	Car      string            `degache:"carplate,type=special,omitempty"`
+	Backup   *string           `degache:"phone,omitempty"`
+	Address  testAddress
+	Internal string `degache:"-"`
+}
+
+type testCompany struct {
+	TaxID     types.TaxID `degache:"taxID"`
+	RIBs      []types.RIB `degache:"rib"`
+	Employees []testEmployee
+	Contacts  map[string]string `degache:"phone"`
+	Branches  map[string]*testAddress
+}
+
+func TestValidateStruct(t *testing.T) {
+	validEmployee := testEmployee{
+		CIN:     "12345678",
+		Phone:   "20123456",
+		Address: testAddress{PostalCode: "1000"},
+	}
+	badPhone := "10123456"
+
+	company := testCompany{
+		TaxID: "1234567A/P/M/000",
+		RIBs:  []types.RIB{"01234567890123456789", "0123"},
+		Employees: []testEmployee{
+			validEmployee,
+			{
+				CIN:     "22345678",
+				Phone:   "20 123 456",
+				Car:     "RS 123 تونس",
+				Backup:  &badPhone,
+				Address: testAddress{PostalCode: "10a0"},
+			},
+		},
+		Contacts: map[string]string{"home": "20123456", "work": "123"},
+		Branches: map[string]*testAddress{"sfax": {PostalCode: "300"}, "tunis": nil},
+	}
+
+	err := ValidateStruct(&company)
+	var structErr StructError
+	if !errors.As(err, &structErr) {
+		t.Fatalf("ValidateStruct() = %v, want StructError", err)
+	}
+
+	want := map[string]types.ErrorCode{
+		"RIBs[1]":                         types.ErrTooShort,
+		"Employees[1].CIN":                types.ErrBadPrefix,
+		"Employees[1].Phone":              types.ErrBadFormat,
+		"Employees[1].Backup":             types.ErrBadPrefix,
+		"Employees[1].Address.PostalCode": types.ErrInvalidCharacter,
+		"Contacts[work]":                  types.ErrTooShort,
+		"Branches[sfax].PostalCode":       types.ErrTooShort,
+	}
+
+	if len(structErr) != len(want) {
+		t.Errorf("ValidateStruct() returned %d failures, want %d: %v", len(structErr), len(want), structErr)
+	}
+	for _, fieldErr := range structErr {
+		code, ok := want[fieldErr.Path]
+		if !ok {
+			t.Errorf("unexpected failure %q: %v", fieldErr.Path, fieldErr.Err)
+			continue
+		}
+		if !errors.Is(fieldErr, code) {
+			t.Errorf("%s: error %v does not match %q", fieldErr.Path, fieldErr.Err, code)
+		}
+	}
+
+	if !errors.Is(err, types.ErrBadPrefix) || errors.Is(err, types.ErrUnknownBank) {
+		t.Error("errors.Is should inspect each field failure")
+	}
+
+	if err := ValidateStruct(validEmployee); err != nil {
+		t.Errorf("ValidateStruct(valid) = %v, want nil", err)
+	}
+}
+
+func TestValidateStructMisuse(t *testing.T) {
+	if err := ValidateStruct("12345678"); err == nil {
+		t.Error("ValidateStruct(non-struct) should fail")
+	}
+
+	var nilEmployee *testEmployee
+	if err := ValidateStruct(nilEmployee); err == nil {
+		t.Error("ValidateStruct(nil) should fail")
+	}
+
+	unknown := struct {
+		Badge string `degache:"badge"`
+	}{"B-1"}
+	if err := ValidateStruct(unknown); !errors.Is(err, ErrUnknownValidator) {
+		t.Errorf("ValidateStruct(unknown tag) = %v, want ErrUnknownValidator", err)
+	}
+
+	wrongKind := struct {
+		Age int `degache:"cin"`
+	}{42}
+	if err := ValidateStruct(wrongKind); err == nil {
+		t.Error("ValidateStruct(int field) should fail")
+	}
+}
+
+type testBranch struct {
+	Address testAddress
+}
+
+type testNetwork struct {
+	Branch  *testBranch
+	Address *testAddress
+	Backup  *testAddress
+}
+
+type testNode struct {
+	Address testAddress
+	Next    *testNode
+}
+
+func TestValidateStructPointers(t *testing.T) {
+	branch := &testBranch{Address: testAddress{PostalCode: "300"}}
+	node := &testNode{Address: testAddress{PostalCode: "10a0"}}
+	node.Next = node
+	slice := []any{nil, testAddress{PostalCode: "10a0"}}
+	slice[0] = slice
+	dict := map[string]any{"address": testAddress{PostalCode: "10a0"}}
+	dict["self"] = dict
+	tagged := []any{nil, "12345"}
+	tagged[0] = tagged
+
+	tests := []struct {
+		name  string
+		value any
+		paths []string
+	}{
+		{"pointer to a struct and to its first field", testNetwork{Branch: branch, Address: &branch.Address},
+			[]string{"Branch.Address.PostalCode", "Address.PostalCode"}},
+		{"shared pointer", testNetwork{Address: &branch.Address, Backup: &branch.Address},
+			[]string{"Address.PostalCode", "Backup.PostalCode"}},
+		{"cycle", node, []string{"Address.PostalCode", "Next.Address.PostalCode"}},
+		{"self-referencing slice", struct{ Any any }{slice}, []string{"Any[1].PostalCode"}},
+		{"self-referencing map", struct{ Any any }{dict}, []string{"Any[address].PostalCode"}},
+		{"self-referencing tagged slice", struct {
+			Codes any `degache:"postal"`
+		}{tagged}, []string{"Codes[1]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var structErr StructError
+			if err := ValidateStruct(tt.value); !errors.As(err, &structErr) {
+				t.Fatalf("ValidateStruct() = %v, want StructError", err)
+			}
+
+			var paths []string
+			for _, fieldErr := range structErr {
+				paths = append(paths, fieldErr.Path)
+			}
+			if fmt.Sprint(paths) != fmt.Sprint(tt.paths) {
+				t.Errorf("ValidateStruct() failed on %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
+
+type testPerson struct {
+	CIN types.CIN `degache:"cin"`
+}
+
+type testIdentity struct {
+	Phone types.PhoneNumber `degache:"phone"`
+}
+
+type EmbeddedContact struct {
+	Postal string `degache:"postal"`
+}
+
+func TestValidateStructEmbedded(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		paths []string
+	}{
+		{"unexported embedded struct", struct{ testPerson }{testPerson{"bad"}}, []string{"CIN"}},
+		{"unexported embedded pointer", struct{ *testIdentity }{&testIdentity{"10123456"}}, []string{"Phone"}},
+		{"nil embedded pointer", struct{ *testIdentity }{}, nil},
+		{"exported embedded struct", struct {
+			EmbeddedContact
+			Address testAddress
+		}{EmbeddedContact{"10a0"}, testAddress{PostalCode: "300"}}, []string{"Postal", "Address.PostalCode"}},
+		{"nested embedding", struct{ Outer struct{ testPerson } }{struct{ testPerson }{testPerson{"2"}}}, []string{"Outer.CIN"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStruct(tt.value)
+			var structErr StructError
+			if tt.paths == nil {
+				if err != nil {
+					t.Fatalf("ValidateStruct() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.As(err, &structErr) {
+				t.Fatalf("ValidateStruct() = %v, want StructError", err)
+			}
+
+			var paths []string
+			for _, fieldErr := range structErr {
+				paths = append(paths, fieldErr.Path)
+			}
+			if fmt.Sprint(paths) != fmt.Sprint(tt.paths) {
+				t.Errorf("ValidateStruct() failed on %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
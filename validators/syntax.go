@@ -0,0 +1,184 @@
+package validators
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/degache-go/degache/constants"
+	"github.com/degache-go/degache/internal/scan"
+)
+
+// Syntax describes the canonical form of a kind of value: the values its
+// pattern matches are exactly those accepted by the validator in strict mode.
+// Patterns are built from the same constants as the validators (tax ID layout,
+// carrier prefixes, bank codes, plate region) and are anchored regular
+// expressions valid in both Go (RE2) and ECMAScript, so they can be used in
+// JSON Schema, HTML forms and JavaScript. TestSyntaxMatchesValidators keeps
+// them in agreement with the validators.
+type Syntax struct {
+	// Name identifies the syntax (e.g. "cin", "phoneE164")
+	Name string `json:"name"`
+	// Validator is the name of the registered validator accepting the values, empty if there is none
+	Validator string `json:"validator,omitempty"`
+	// Pattern is the anchored regular expression matching the canonical form
+	Pattern string `json:"pattern"`
+	// MinLength and MaxLength bound the length in characters (not bytes)
+	MinLength int `json:"minLength"`
+	MaxLength int `json:"maxLength"`
+	// Masks are input masks of the values: 9 is a digit, A an uppercase letter
+	// and any other character is literal. They may add separators that the
+	// canonical form does not have, but only ones the validator accepts.
+	Masks []string `json:"masks"`
+	// Examples are valid values
+	Examples []string `json:"examples"`
+}
+
+// syntaxes lists the syntaxes in a stable order
+var syntaxes = buildSyntaxes()
+
+// buildSyntaxes derives the syntaxes from the validation rules
+func buildSyntaxes() []Syntax {
+	prefixes := "[" + strings.Join(constants.ValidPrefixes, "") + "]"
+	ribPattern := "(?:" + strings.Join(bankCodes(), "|") + ")[0-9]{18}"
+	region := regexp.QuoteMeta(carPlateRegion)
+
+	// Layouts of the canonical forms, from which the length bounds are derived:
+	// 9 is a digit, A an uppercase letter and any other character is literal
+	var (
+		cin    = "9" + strings.Repeat("9", 7)   // 0 or 1, then 7 digits
+		phone  = "9" + strings.Repeat("9", 7)   // carrier prefix, then 7 digits
+		rib    = "99" + strings.Repeat("9", 18) // bank code, then 18 digits
+		iban   = "TN99" + rib
+		postal = "9999"
+		plates = []string{"999 " + carPlateRegion + " 9999", "RS 999 " + carPlateRegion}
+	)
+
+	return []Syntax{
+		withLayouts(Syntax{Name: "cin", Validator: "cin", Pattern: "^[01][0-9]{7}$",
+			Masks:    []string{cin},
+			Examples: []string{"01234567", "12345678"}}, cin),
+		withLayouts(Syntax{Name: "phone", Validator: "phone", Pattern: "^" + prefixes + "[0-9]{7}$",
+			Masks:    []string{"99 999 999"},
+			Examples: []string{"20123456", "98123456"}}, phone),
+		withLayouts(Syntax{Name: "phoneE164", Validator: "phone", Pattern: "^" + regexp.QuoteMeta(constants.CountryCode) + prefixes + "[0-9]{7}$",
+			Masks:    []string{constants.CountryCode + " 99 999 999"},
+			Examples: []string{constants.CountryCode + "20123456", constants.CountryCode + "98123456"}}, constants.CountryCode+phone),
+		withLayouts(Syntax{Name: "taxID", Validator: "taxID", Pattern: "^" + layoutPattern(scan.TaxIDLayout) + "$",
+			Masks:    []string{scan.TaxIDLayout},
+			Examples: []string{"1234567A/P/M/000"}}, scan.TaxIDLayout),
+		// The RIB validator rejects separators, so the masks have none
+		withLayouts(Syntax{Name: "rib", Validator: "rib", Pattern: "^" + ribPattern + "$",
+			Masks:    []string{rib},
+			Examples: []string{"01234567890123456789"}}, rib),
+		// The IBAN check digits (ISO 7064 mod 97-10) cannot be expressed as a pattern
+		withLayouts(Syntax{Name: "iban", Pattern: "^TN[0-9]{2}" + ribPattern + "$",
+			Masks:    []string{iban},
+			Examples: []string{"TN8001234567890123456789"}}, iban),
+		withLayouts(Syntax{Name: "postal", Validator: "postal", Pattern: "^[0-9]{4}$",
+			Masks:    []string{postal},
+			Examples: []string{"1000", "3000"}}, postal),
+		withLayouts(Syntax{Name: "carPlate", Validator: "carPlate",
+			Pattern:  "^(?:[0-9]{3} " + region + " [0-9]{4}|RS [0-9]{3} " + region + ")$",
+			Masks:    plates,
+			Examples: []string{"123 " + carPlateRegion + " 4567", "RS 123 " + carPlateRegion}}, plates...),
+	}
+}
+
+// withLayouts sets the length bounds of s to those of the layouts of its canonical form
+func withLayouts(s Syntax, layouts ...string) Syntax {
+	s.MinLength, s.MaxLength = -1, 0
+	for _, layout := range layouts {
+		n := utf8.RuneCountInString(layout)
+		if s.MinLength < 0 || n < s.MinLength {
+			s.MinLength = n
+		}
+		if n > s.MaxLength {
+			s.MaxLength = n
+		}
+	}
+	return s
+}
+
+// bankCodes returns the known bank codes in ascending order
+func bankCodes() []string {
+	codes := make([]string, 0, len(constants.Banks))
+	for code := range constants.Banks {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// layoutPattern converts a layout such as scan.TaxIDLayout to a regular expression
+func layoutPattern(layout string) string {
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		c := layout[i]
+		n := 1
+		for i+n < len(layout) && layout[i+n] == c {
+			n++
+		}
+		i += n
+
+		var class string
+		switch c {
+		case '9':
+			class = "[0-9]"
+		case 'A':
+			class = "[A-Z]"
+		default:
+			b.WriteString(regexp.QuoteMeta(strings.Repeat(string(c), n)))
+			continue
+		}
+		b.WriteString(class)
+		if n > 1 {
+			b.WriteString("{" + strconv.Itoa(n) + "}")
+		}
+	}
+	return b.String()
+}
+
+// Syntaxes returns the syntax of every kind of value
+//
+// Returns:
+//   - []Syntax: the syntaxes of cin, phone, phoneE164, taxID, rib, iban, postal and carPlate
+//
+// Example:
+//
+//	for _, s := range Syntaxes() {
+//	    fmt.Println(s.Name, s.Pattern)
+//	}
+func Syntaxes() []Syntax {
+	list := make([]Syntax, len(syntaxes))
+	copy(list, syntaxes)
+	for i := range list {
+		list[i].Masks = append([]string(nil), list[i].Masks...)
+		list[i].Examples = append([]string(nil), list[i].Examples...)
+	}
+	return list
+}
+
+// SyntaxOf returns the syntax named name (case-insensitive)
+//
+// Parameters:
+//   - name: The syntax name (e.g. "rib", "phoneE164")
+//
+// Returns:
+//   - Syntax: the syntax
+//   - bool: false if there is no syntax with that name
+//
+// Example:
+//
+//	s, _ := SyntaxOf("postal")
+//	fmt.Println(s.Pattern) // ^[0-9]{4}$
+func SyntaxOf(name string) (Syntax, bool) {
+	for _, s := range Syntaxes() {
+		if strings.EqualFold(s.Name, name) {
+			return s, true
+		}
+	}
+	return Syntax{}, false
+}
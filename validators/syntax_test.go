@@ -0,0 +1,188 @@
+package validators
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/degache-go/degache/internal/scan"
+	"github.com/degache-go/degache/types"
+)
+
+// strictValidators accept exactly the values matched by the pattern of each syntax
+var strictValidators = map[string]func(string) bool{
+	"cin": func(s string) bool { return ValidateCIN(s, types.ValidationOptions{Strict: true}) },
+	"phone": func(s string) bool {
+		return !strings.HasPrefix(s, "+") && ValidatePhoneNumber(s, types.PhoneNumberValidationOptions{Strict: true})
+	},
+	"phoneE164": func(s string) bool {
+		return strings.HasPrefix(s, "+") && ValidatePhoneNumber(s, types.PhoneNumberValidationOptions{Strict: true})
+	},
+	"taxID": func(s string) bool { return ValidateTaxID(s, types.ValidationOptions{Strict: true}) },
+	"rib":   func(s string) bool { return ValidateRIB(s, types.ValidationOptions{Strict: true}) },
+	"iban": func(s string) bool {
+		return len(s) == 24 && strings.HasPrefix(s, "TN") && scan.IsDigits(s[2:4]) &&
+			ValidateRIB(s[4:], types.ValidationOptions{Strict: true})
+	},
+	"postal": func(s string) bool { return ValidatePostalCode(s, types.ValidationOptions{Strict: true}) },
+	"carPlate": func(s string) bool {
+		return ValidateCarPlate(s, types.CarPlateValidationOptions{Strict: true}) ||
+			ValidateCarPlate(s, types.CarPlateValidationOptions{Type: "special", Strict: true})
+	},
+}
+
+// mutations returns s with every character replaced, deleted or doubled, and every byte-level insertion of a few characters
+func mutations(s string) []string {
+	runes := []rune(s)
+	var out []string
+	for i := range runes {
+		for _, r := range "09AZaz/ +-RSت" {
+			out = append(out, string(runes[:i])+string(r)+string(runes[i+1:]))
+			out = append(out, string(runes[:i])+string(r)+string(runes[i:]))
+		}
+		out = append(out, string(runes[:i])+string(runes[i+1:]))
+		out = append(out, string(runes[:i+1])+string(runes[i:]))
+	}
+	return append(out, s+"0", " "+s, s+" ")
+}
+
+// TestSyntaxMatchesValidators checks that the pattern of every syntax accepts
+// exactly the values accepted by the strict validator
+func TestSyntaxMatchesValidators(t *testing.T) {
+	for _, syntax := range Syntaxes() {
+		validate, ok := strictValidators[syntax.Name]
+		if !ok {
+			t.Errorf("no strict validator for syntax %q", syntax.Name)
+			continue
+		}
+		pattern := regexp.MustCompile(syntax.Pattern)
+
+		corpus := append([]string(nil), scanCorpus...)
+		for _, example := range syntax.Examples {
+			if !validate(example) {
+				t.Errorf("%s: example %q is invalid", syntax.Name, example)
+			}
+			corpus = append(corpus, mutations(example)...)
+		}
+
+		for _, s := range corpus {
+			if pattern.MatchString(s) != validate(s) {
+				t.Errorf("%s: pattern %s matches %q = %v, validator = %v",
+					syntax.Name, syntax.Pattern, s, pattern.MatchString(s), validate(s))
+			}
+			if n := len([]rune(s)); pattern.MatchString(s) && (n < syntax.MinLength || n > syntax.MaxLength) {
+				t.Errorf("%s: %q has %d characters, outside [%d, %d]", syntax.Name, s, n, syntax.MinLength, syntax.MaxLength)
+			}
+		}
+	}
+}
+
+// TestSyntaxPatternsArePortable rejects RE2 constructs that ECMAScript does not support
+func TestSyntaxPatternsArePortable(t *testing.T) {
+	for _, syntax := range Syntaxes() {
+		for _, construct := range []string{"(?P<", "(?i", "(?s", "\\A", "\\z", "[[:", "\\p", "\\Q"} {
+			if strings.Contains(syntax.Pattern, construct) {
+				t.Errorf("%s: pattern %s uses %s", syntax.Name, syntax.Pattern, construct)
+			}
+		}
+		if !strings.HasPrefix(syntax.Pattern, "^") || !strings.HasSuffix(syntax.Pattern, "$") {
+			t.Errorf("%s: pattern %s is not anchored", syntax.Name, syntax.Pattern)
+		}
+	}
+}
+
+func TestIBANExample(t *testing.T) {
+	syntax, _ := SyntaxOf("IBAN")
+	for _, iban := range syntax.Examples {
+		// ISO 13616: move the country code and check digits to the end, letters as numbers (T=29, N=23)
+		n, _ := new(big.Int).SetString(iban[4:]+"2923"+iban[2:4], 10)
+		if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+			t.Errorf("example %q has invalid check digits", iban)
+		}
+	}
+}
+
+// applyMask fills the slots of mask with the letters and digits of value,
+// copying literals and consuming them from value when they are present there
+func applyMask(mask, value string) (string, bool) {
+	in := []rune(value)
+	var b strings.Builder
+	for _, m := range mask {
+		switch m {
+		case '9', 'A':
+			for len(in) > 0 && !isSlot(in[0]) {
+				in = in[1:]
+			}
+			if len(in) == 0 || (m == '9') != (in[0] >= '0' && in[0] <= '9') {
+				return "", false
+			}
+			b.WriteRune(in[0])
+			in = in[1:]
+		default:
+			if len(in) > 0 && in[0] == m {
+				in = in[1:]
+			}
+			b.WriteRune(m)
+		}
+	}
+	return b.String(), len(in) == 0
+}
+
+// isSlot reports whether r can fill a mask slot
+func isSlot(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'A' && r <= 'Z'
+}
+
+// TestSyntaxMasks checks that every example fits a mask and that the masked
+// value is accepted by the validator in its default (normalizing) mode, or
+// matches the pattern if there is no validator
+func TestSyntaxMasks(t *testing.T) {
+	for _, syntax := range Syntaxes() {
+		for _, example := range syntax.Examples {
+			fitted := false
+			for _, mask := range syntax.Masks {
+				masked, ok := applyMask(mask, example)
+				if !ok {
+					continue
+				}
+				fitted = true
+				if syntax.Validator == "" {
+					if !regexp.MustCompile(syntax.Pattern).MatchString(masked) {
+						t.Errorf("%s: masked example %q does not match %s", syntax.Name, masked, syntax.Pattern)
+					}
+					continue
+				}
+				opts := Options{}
+				if strings.HasPrefix(example, "RS") {
+					opts.Params = map[string]string{"type": "special"}
+				}
+				if err := DefaultRegistry.Check(syntax.Validator, masked, opts); err != nil {
+					t.Errorf("%s: masked example %q: %v", syntax.Name, masked, err)
+				}
+			}
+			if !fitted {
+				t.Errorf("%s: example %q fits no mask of %q", syntax.Name, example, syntax.Masks)
+			}
+		}
+	}
+}
+
+func TestSyntaxLengths(t *testing.T) {
+	want := map[string][2]int{
+		"cin":       {8, 8},
+		"phone":     {8, 8},
+		"phoneE164": {12, 12},
+		"taxID":     {16, 16},
+		"rib":       {20, 20},
+		"iban":      {24, 24},
+		"postal":    {4, 4},
+		"carPlate":  {11, 13},
+	}
+
+	for _, syntax := range Syntaxes() {
+		if got := [2]int{syntax.MinLength, syntax.MaxLength}; got != want[syntax.Name] {
+			t.Errorf("%s: lengths = %v, want %v", syntax.Name, got, want[syntax.Name])
+		}
+	}
+}
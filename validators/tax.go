@@ -2,18 +2,16 @@ package validators
 
 import (
 	"fmt"
-	"regexp"
-)
 
-// taxIDRegex is the regular expression for Tax ID validation
-// Format: 7 digits followed by letter/letter/letter/3 digits
-var taxIDRegex = regexp.MustCompile(`^\d{7}[A-Z]/[A-Z]/[A-Z]/\d{3}$`)
+	"github.com/degache-go/degache/types"
+)
 
 // ValidateTaxID validates a Tunisian Tax ID (Matricule Fiscal)
 // A valid Tax ID follows the format: 7 digits + letter/letter/letter/3 digits
 //
 // Parameters:
 //   - taxID: The Tax ID to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the Tax ID is valid, false otherwise
@@ -23,18 +21,15 @@ var taxIDRegex = regexp.MustCompile(`^\d{7}[A-Z]/[A-Z]/[A-Z]/\d{3}$`)
 //	isValid := ValidateTaxID("1234567A/P/M/000") // returns true
 //	isValid := ValidateTaxID("123456A/P/M/000")  // returns false (not 7 digits)
 //	isValid := ValidateTaxID("1234567A/P/M/00")  // returns false (not 3 digits at end)
-func ValidateTaxID(taxID string) bool {
-	if taxID == "" {
-		return false
-	}
-
-	return taxIDRegex.MatchString(taxID)
+func ValidateTaxID(taxID string, options ...types.ValidationOptions) bool {
+	return isTaxID(normalizeInput(taxID, options))
 }
 
 // ValidateTaxIDWithDetails validates a Tax ID and returns detailed information
 //
 // Parameters:
 //   - taxID: The Tax ID to validate
+//   - options: Validation options (optional, Strict disables input normalization)
 //
 // Returns:
 //   - bool: true if the Tax ID is valid
@@ -46,20 +41,28 @@ func ValidateTaxID(taxID string) bool {
 //	if !valid {
 //	    fmt.Println("Invalid Tax ID:", msg)
 //	}
-func ValidateTaxIDWithDetails(taxID string) (bool, string) {
-	if taxID == "" {
-		return false, "Tax ID cannot be empty"
-	}
-
-	if len(taxID) != 15 {
-		return false, "Tax ID must be exactly 15 characters long"
-	}
-
-	if !taxIDRegex.MatchString(taxID) {
-		return false, "Tax ID must follow format: 7 digits + letter/letter/letter/3 digits (e.g., 1234567A/P/M/000)"
-	}
+func ValidateTaxIDWithDetails(taxID string, options ...types.ValidationOptions) (bool, string) {
+	return details(CheckTaxID(taxID, options...))
+}
 
-	return true, ""
+// CheckTaxID validates a Tax ID and returns a structured error describing the failure.
+// Outside strict mode, error positions refer to the normalized input.
+//
+// Parameters:
+//   - taxID: The Tax ID to validate
+//   - options: Validation options (optional, Strict disables input normalization)
+//
+// Returns:
+//   - error: nil if valid, otherwise a *types.ValidationError
+//
+// Example:
+//
+//	err := CheckTaxID("1234567a/P/M/000")
+//	if verr := AsValidationError(err); verr != nil {
+//	    fmt.Println(verr.Position, verr.Expected) // 7 uppercase letter
+//	}
+func CheckTaxID(taxID string, options ...types.ValidationOptions) error {
+	return types.TaxID(normalizeInput(taxID, options)).Validate()
 }
 
 // ExtractTaxIDComponents extracts components from a valid Tax ID
@@ -79,16 +82,23 @@ func ValidateTaxIDWithDetails(taxID string) (bool, string) {
 //	        components["number"], components["type1"], components["type2"], components["type3"], components["sequence"])
 //	}
 func ExtractTaxIDComponents(taxID string) (map[string]string, error) {
-	if !ValidateTaxID(taxID) {
+	taxID = Normalize(taxID)
+	if !ValidateTaxID(taxID, types.ValidationOptions{Strict: true}) {
 		return nil, fmt.Errorf("invalid Tax ID format")
 	}
 
+	return taxIDComponents(types.TaxID(taxID)), nil
+}
+
+// taxIDComponents returns the components of a valid Tax ID keyed as in ExtractTaxIDComponents
+func taxIDComponents(taxID types.TaxID) map[string]string {
+	parts := taxID.Components()
 	components := make(map[string]string)
-	components["number"] = taxID[:7]
-	components["type1"] = string(taxID[7])
-	components["type2"] = string(taxID[9])
-	components["type3"] = string(taxID[11])
-	components["sequence"] = taxID[13:]
+	components["number"] = parts.Number
+	components["type1"] = parts.Type1
+	components["type2"] = parts.Type2
+	components["type3"] = parts.Type3
+	components["sequence"] = parts.Sequence
 
-	return components, nil
+	return components
 }